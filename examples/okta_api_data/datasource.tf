@@ -0,0 +1,8 @@
+data "okta_api_data" "test" {
+  path              = "/api/v1/brands"
+  follow_pagination = true
+  jsonpath = {
+    first_brand_id = "$[0].id"
+    brand_ids      = "$[*].id"
+  }
+}
@@ -0,0 +1,13 @@
+resource "okta_api_object" "test" {
+  create_path    = "/api/v1/trustedOrigins"
+  compare_fields = ["name", "origin", "scopes"]
+  data = jsonencode({
+    name   = "testAcc_replace_with_uuid"
+    origin = "https://example-replace_with_uuid.com"
+    scopes = [
+      {
+        type = "CORS"
+      }
+    ]
+  })
+}
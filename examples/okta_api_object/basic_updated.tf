@@ -0,0 +1,17 @@
+resource "okta_api_object" "test" {
+  create_path    = "/api/v1/trustedOrigins"
+  update_path    = "/api/v1/trustedOrigins/{id}"
+  compare_fields = ["name", "origin", "scopes"]
+  data = jsonencode({
+    name   = "testAcc_replace_with_uuid_updated"
+    origin = "https://example-replace_with_uuid.com"
+    scopes = [
+      {
+        type = "CORS"
+      },
+      {
+        type = "REDIRECT"
+      }
+    ]
+  })
+}
@@ -0,0 +1,18 @@
+resource "okta_app_saml" "test" {
+  preconfigured_app = "okta_org2org"
+  label             = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_features" "test" {
+  app_id = okta_app_saml.test.id
+
+  user_provisioning {
+    create_users           = true
+    update_user_attributes = true
+    deactivate_users       = true
+  }
+}
+
+data "okta_app_features" "test" {
+  app_id = okta_app_features.test.app_id
+}
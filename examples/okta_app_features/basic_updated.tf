@@ -0,0 +1,28 @@
+resource "okta_app_saml" "test" {
+  preconfigured_app = "okta_org2org"
+  label             = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_features" "test" {
+  app_id = okta_app_saml.test.id
+
+  user_provisioning {
+    create_users           = true
+    update_user_attributes = false
+    deactivate_users       = true
+    password_sync          = true
+    password_seed          = "OKTA"
+  }
+
+  inbound_provisioning {
+    schedule_enabled              = true
+    full_import_expression        = "0 0 * * 0"
+    full_import_timezone          = "America/New_York"
+    incremental_import_expression = "0 */6 * * *"
+    incremental_import_timezone   = "America/New_York"
+    exact_match_criteria          = "EMAIL"
+    auto_confirm_exact_match      = true
+    auto_confirm_new_users        = true
+    auto_activate_new_users       = true
+  }
+}
@@ -0,0 +1,17 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["implicit", "authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code", "token", "id_token"]
+  issuer_mode    = "ORG_URL"
+  groups         = [okta_group.test1.id, okta_group.test2.id]
+}
+
+resource "okta_group" "test1" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group" "test2" {
+  name = "testAcc_replace_with_uuid_2"
+}
@@ -0,0 +1,28 @@
+resource "okta_app_saml" "test" {
+  preconfigured_app = "okta_org2org"
+  label             = "testAcc_replace_with_uuid"
+}
+
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_group_assignment" "test" {
+  app_id   = okta_app_saml.test.id
+  group_id = okta_group.test.id
+}
+
+resource "okta_app_group_push_mapping" "test" {
+  app_id                         = okta_app_saml.test.id
+  source_group_id                = okta_group.test.id
+  target_group_name              = "testAcc_replace_with_uuid"
+  status                         = "ACTIVE"
+  delete_target_group_on_destroy = true
+
+  depends_on = [okta_app_group_assignment.test]
+}
+
+data "okta_app_group_push_mappings" "test" {
+  app_id          = okta_app_group_push_mapping.test.app_id
+  source_group_id = okta_group.test.id
+}
@@ -0,0 +1,37 @@
+resource "okta_app_saml" "test" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "https://example.com/sso"
+  recipient                = "https://example.com/sso"
+  destination              = "https://example.com/sso"
+  audience                 = "https://example.com/audience"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_app_saml" "target" {
+  label                    = "testAcc_target_replace_with_uuid"
+  sso_url                  = "https://example.com/sso"
+  recipient                = "https://example.com/sso"
+  destination              = "https://example.com/sso"
+  audience                 = "https://example.com/audience"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_app_key" "test" {
+  app_id = okta_app_saml.test.id
+}
+
+resource "okta_app_key_clone" "test" {
+  app_id        = okta_app_saml.test.id
+  key_id        = okta_app_key.test.id
+  target_app_id = okta_app_saml.target.id
+}
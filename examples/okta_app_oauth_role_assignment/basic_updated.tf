@@ -0,0 +1,44 @@
+resource "okta_app_oauth" "test" {
+  label                      = "testAcc_replace_with_uuid"
+  type                       = "service"
+  response_types             = ["token"]
+  grant_types                = ["client_credentials"]
+  token_endpoint_auth_method = "private_key_jwt"
+
+  jwks {
+    kty = "RSA"
+    kid = "SIGNING_KEY"
+    e   = "AQAB"
+    n   = "owfoXNHcAlAVpIO41840ZU2tZraLGw3yEr3xZvAti7oEZPUKCytk88IDgH7440JOuz8GC_D6vtduWOqnEt0j0_faJnhKHgfj7DTWBOCxzSdjrM-Uyj6-e_XLFvZXzYsQvt52PnBJUV15G1W9QTjlghT_pFrW0xrTtbO1c281u1HJdPd5BeIyPb0pGbciySlx53OqGyxrAxPAt5P5h-n36HJkVsSQtNvgptLyOwWYkX50lgnh2szbJ0_O581bqkNBy9uqlnVeK1RZDQUl4mk8roWYhsx_JOgjpC3YyeXA6hHsT5xWZos_gNx98AHivNaAjzIzvyVItX2-hP0Aoscfff"
+  }
+}
+
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing"
+}
+
+resource "okta_group" "test_1" {
+  name        = "testAcc_replace_with_uuid_1"
+  description = "testing"
+}
+
+resource "okta_app_swa" "test" {
+  label          = "testAcc_replace_with_uuid"
+  button_field   = "btn-login"
+  password_field = "txtbox-password"
+  username_field = "txtbox-username"
+  url            = "https://example.com/login.html"
+}
+
+resource "okta_app_oauth_role_assignment" "test" {
+  client_id = okta_app_oauth.test.client_id
+  type      = "HELP_DESK_ADMIN"
+  groups    = [okta_group.test.id, okta_group.test_1.id]
+}
+
+resource "okta_app_oauth_role_assignment" "test_app" {
+  client_id = okta_app_oauth.test.client_id
+  type      = "APP_ADMIN"
+  apps      = ["facebook", format("%s.%s", okta_app_swa.test.name, okta_app_swa.test.id)]
+}
@@ -0,0 +1,40 @@
+locals {
+  org_url = "https://terraform-provider-okta.oktapreview.com"
+}
+
+resource "okta_app_oauth" "test" {
+  label                      = "testAcc_replace_with_uuid"
+  type                       = "service"
+  response_types             = ["token"]
+  grant_types                = ["client_credentials"]
+  token_endpoint_auth_method = "private_key_jwt"
+
+  jwks {
+    kty = "RSA"
+    kid = "SIGNING_KEY"
+    e   = "AQAB"
+    n   = "owfoXNHcAlAVpIO41840ZU2tZraLGw3yEr3xZvAti7oEZPUKCytk88IDgH7440JOuz8GC_D6vtduWOqnEt0j0_faJnhKHgfj7DTWBOCxzSdjrM-Uyj6-e_XLFvZXzYsQvt52PnBJUV15G1W9QTjlghT_pFrW0xrTtbO1c281u1HJdPd5BeIyPb0pGbciySlx53OqGyxrAxPAt5P5h-n36HJkVsSQtNvgptLyOwWYkX50lgnh2szbJ0_O581bqkNBy9uqlnVeK1RZDQUl4mk8roWYhsx_JOgjpC3YyeXA6hHsT5xWZos_gNx98AHivNaAjzIzvyVItX2-hP0Aoscfff"
+  }
+}
+
+resource "okta_admin_role_custom" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  permissions = ["okta.users.manage", "okta.groups.manage"]
+}
+
+resource "okta_resource_set" "test" {
+  label       = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+  resources = [
+    format("%s/api/v1/users", local.org_url),
+    format("%s/api/v1/groups", local.org_url)
+  ]
+}
+
+resource "okta_app_oauth_role_assignment" "test" {
+  client_id    = okta_app_oauth.test.client_id
+  type         = "CUSTOM"
+  role         = okta_admin_role_custom.test.id
+  resource_set = okta_resource_set.test.id
+}
@@ -0,0 +1,12 @@
+resource "okta_app_saml" "test" {
+  preconfigured_app = "okta_org2org"
+  label             = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_provisioning_connection" "test" {
+  app_id      = okta_app_saml.test.id
+  auth_scheme = "TOKEN"
+  token       = "testAcc_replace_with_uuid"
+  base_url    = "https://example.okta.com"
+  enabled     = false
+}
@@ -0,0 +1,11 @@
+resource "okta_auth_server" "test" {
+  audiences                 = ["api://testAcc_replace_with_uuid"]
+  credentials_rotation_mode = "MANUAL"
+  name                      = "testAcc_replace_with_uuid"
+}
+
+resource "okta_auth_server_key_rotation" "test" {
+  auth_server_id   = okta_auth_server.test.id
+  rotation_trigger = "2"
+  max_age          = "720h"
+}
@@ -0,0 +1,9 @@
+resource "okta_auth_server" "test" {
+  audiences                 = ["api://testAcc_replace_with_uuid"]
+  credentials_rotation_mode = "MANUAL"
+  name                      = "testAcc_replace_with_uuid"
+}
+
+data "okta_auth_server_keys" "test" {
+  auth_server_id = okta_auth_server.test.id
+}
@@ -0,0 +1,33 @@
+resource "okta_push_provider_apns" "test" {
+  name              = "testAcc_replace_with_uuid"
+  team_id           = "ABCDE12345"
+  key_id            = "ABC123DEFG"
+  token_signing_key = "../examples/okta_push_provider_apns/test_token_signing_key.p8"
+}
+
+resource "okta_push_provider_fcm" "test" {
+  name                 = "testAcc_replace_with_uuid"
+  service_account_json = file("../examples/okta_push_provider_fcm/test_service_account.json")
+}
+
+resource "okta_authenticator" "test" {
+  name = "testAcc_replace_with_uuid"
+  key  = "custom_app"
+  settings = jsonencode({
+    "userVerification" : "PREFERRED",
+    "appInstanceId" : "0oa1234567890abcdef"
+  })
+  provider_json = jsonencode({
+    "type" : "PUSH",
+    "configuration" : {
+      "apns" : {
+        "id" : okta_push_provider_apns.test.id,
+        "appBundleId" : "com.example.testacc",
+        "debugAppBundleId" : "com.example.testacc.debug"
+      },
+      "fcm" : {
+        "id" : okta_push_provider_fcm.test.id
+      }
+    }
+  })
+}
@@ -0,0 +1,6 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_error_page" "test" {
+  brand_id = tolist(data.okta_brands.test.brands)[0].id
+}
@@ -0,0 +1,22 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_error_page" "test" {
+  brand_id     = tolist(data.okta_brands.test.brands)[0].id
+  page_content = <<-EOT
+    <!DOCTYPE html>
+    <html>
+      <head><title>{{orgName}} - {{errorSummary}}</title></head>
+      <body>
+        <h1>{{errorSummary}}</h1>
+        <p>{{errorDescription}}</p>
+        <a href="{{back}}">Go back</a>
+      </body>
+    </html>
+  EOT
+
+  content_security_policy_setting {
+    mode     = "report_only"
+    src_list = ["https://cdn.example.com"]
+  }
+}
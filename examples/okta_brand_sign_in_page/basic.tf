@@ -0,0 +1,14 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_sign_in_page" "test" {
+  brand_id       = tolist(data.okta_brands.test.brands)[0].id
+  widget_version = "*"
+
+  widget_customizations {
+    sign_in_label  = "Sign In"
+    username_label = "Username"
+    help_label     = "Help"
+    help_url       = "https://example.com/help"
+  }
+}
@@ -0,0 +1,34 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_sign_in_page" "test" {
+  brand_id       = tolist(data.okta_brands.test.brands)[0].id
+  widget_version = "7"
+  page_content   = <<-EOT
+    <!DOCTYPE html>
+    <html>
+      <head><title>{{orgName}} - {{Sign In}}</title></head>
+      <body>
+        <div id="okta-login-container"></div>
+        {{{OktaUtil}}}
+        <script type="text/javascript">
+          var config = OktaUtil.getSignInWidgetConfig();
+          var oktaSignIn = new OktaSignIn(config);
+          oktaSignIn.renderEl({ el: '#okta-login-container' }, OktaUtil.completeLogin, function(error) {});
+        </script>
+      </body>
+    </html>
+  EOT
+
+  widget_customizations {
+    sign_in_label                   = "Log In"
+    username_label                  = "Email"
+    show_password_visibility_toggle = true
+  }
+
+  content_security_policy_setting {
+    mode       = "enforced"
+    report_uri = "https://example.com/csp-report"
+    src_list   = ["https://cdn.example.com"]
+  }
+}
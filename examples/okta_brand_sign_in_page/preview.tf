@@ -0,0 +1,12 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_sign_in_page" "test" {
+  brand_id       = tolist(data.okta_brands.test.brands)[0].id
+  preview        = true
+  widget_version = "*"
+
+  widget_customizations {
+    sign_in_label = "Preview Sign In"
+  }
+}
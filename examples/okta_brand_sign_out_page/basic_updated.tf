@@ -0,0 +1,7 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_brand_sign_out_page" "test" {
+  brand_id = tolist(data.okta_brands.test.brands)[0].id
+  type     = "OKTA_DEFAULT"
+}
@@ -0,0 +1,9 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_domain" "test" {
+  brand_id     = tolist(data.okta_brands.test.brands)[0].id
+  domain       = "testAcc-replace_with_uuid.example.com"
+  display_name = "Test Acc Updated"
+  user_name    = "notifications"
+}
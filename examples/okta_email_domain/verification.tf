@@ -0,0 +1,15 @@
+data "okta_brands" "example" {
+}
+
+resource "okta_email_domain" "example" {
+  brand_id     = tolist(data.okta_brands.example.brands)[0].id
+  domain       = "mail.example.com"
+  display_name = "Example"
+  user_name    = "no-reply"
+}
+
+// DNS records have to be created with your DNS provider before the email domain can be verified,
+// the records are exposed by the 'dns_validation_records' attribute of the email domain.
+resource "okta_email_domain_verification" "example" {
+  email_domain_id = okta_email_domain.example.id
+}
@@ -0,0 +1,20 @@
+resource "okta_event_hook" "test" {
+  name = "testAcc_replace_with_uuid"
+  events = [
+    "user.lifecycle.create",
+    "user.lifecycle.delete.initiated",
+  ]
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+  }
+
+  oauth {
+    auth_type     = "client_secret_post"
+    token_url     = "https://example.com/oauth2/token"
+    client_id     = "testAcc_replace_with_uuid"
+    client_secret = "secret"
+    scope         = "hooks"
+  }
+}
@@ -0,0 +1,18 @@
+resource "okta_event_hook" "test" {
+  name = "testAcc_replace_with_uuid"
+  events = [
+    "user.lifecycle.create",
+    "user.lifecycle.delete.initiated",
+  ]
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+  }
+
+  auth = {
+    type  = "HEADER"
+    key   = "Authorization"
+    value = "123"
+  }
+}
@@ -0,0 +1,28 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_group" "owners" {
+  name        = "testAcc_owners_replace_with_uuid"
+  description = "testing, testing"
+}
+
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_group_owner" "test" {
+  group_id = okta_group.test.id
+  owner_id = okta_user.test.id
+  type     = "USER"
+}
+
+resource "okta_group_owner" "test_group" {
+  group_id = okta_group.test.id
+  owner_id = okta_group.owners.id
+  type     = "GROUP"
+}
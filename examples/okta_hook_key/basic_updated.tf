@@ -0,0 +1,3 @@
+resource "okta_hook_key" "test" {
+  name = "testAcc_replace_with_uuid_updated"
+}
@@ -0,0 +1,43 @@
+resource "okta_app_saml" "test" {
+  label                    = "testAcc_replace_with_uuid"
+  sso_url                  = "https://example.com/sso"
+  recipient                = "https://example.com/sso"
+  destination              = "https://example.com/sso"
+  audience                 = "https://example.com/audience"
+  subject_name_id_template = "$${user.userName}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
+
+resource "okta_idp_saml_key" "test" {
+  x5c = [okta_app_saml.test.certificate]
+}
+
+resource "okta_idp_saml" "test" {
+  name                     = "testAcc_replace_with_uuid"
+  acs_type                 = "INSTANCE"
+  sso_url                  = "https://idp.example.com"
+  sso_destination          = "https://idp.example.com"
+  sso_binding              = "HTTP-POST"
+  username_template        = "idpuser.email"
+  kid                      = okta_idp_saml_key.test.id
+  issuer                   = "https://idp.example.com"
+  request_signature_scope  = "REQUEST"
+  response_signature_scope = "ANY"
+}
+
+resource "okta_idp_key" "test" {
+  idp_id = okta_idp_saml.test.id
+}
+
+resource "okta_idp_key" "test_2" {
+  idp_id = okta_idp_saml.test.id
+}
+
+resource "okta_idp_active_signing_key" "test" {
+  idp_id = okta_idp_saml.test.id
+  key_id = okta_idp_key.test_2.id
+}
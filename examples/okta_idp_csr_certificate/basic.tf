@@ -0,0 +1,39 @@
+# The CSR is signed by a private CA managed with the hashicorp/tls provider.
+resource "tls_private_key" "ca" {
+  algorithm = "RSA"
+}
+
+resource "tls_self_signed_cert" "ca" {
+  private_key_pem       = tls_private_key.ca.private_key_pem
+  is_ca_certificate     = true
+  validity_period_hours = 87600
+  allowed_uses          = ["cert_signing"]
+
+  subject {
+    common_name = "Example CA"
+  }
+}
+
+resource "okta_idp_csr" "example" {
+  idp_id = okta_idp_saml.example.id
+
+  subject {
+    common_name       = "IdP Issuer"
+    organization_name = "Example"
+  }
+}
+
+resource "tls_locally_signed_cert" "example" {
+  cert_request_pem      = okta_idp_csr.example.csr_pem
+  ca_private_key_pem    = tls_private_key.ca.private_key_pem
+  ca_cert_pem           = tls_self_signed_cert.ca.cert_pem
+  validity_period_hours = 17520
+  allowed_uses          = ["digital_signature", "key_encipherment"]
+}
+
+resource "okta_idp_csr_certificate" "example" {
+  idp_id      = okta_idp_saml.example.id
+  csr_id      = okta_idp_csr.example.id
+  certificate = tls_locally_signed_cert.example.cert_pem
+}
+
@@ -0,0 +1,23 @@
+resource "okta_hook_key" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_inline_hook" "test" {
+  name    = "testAcc_replace_with_uuid"
+  version = "1.0.1"
+  type    = "com.okta.oauth2.tokens.transform"
+
+  channel = {
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+    method  = "POST"
+  }
+
+  oauth {
+    auth_type = "private_key_jwt"
+    token_url = "https://example.com/oauth2/token"
+    client_id = "testAcc_replace_with_uuid"
+    scope     = "hooks"
+    key_id    = okta_hook_key.test.id
+  }
+}
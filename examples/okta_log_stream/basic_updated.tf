@@ -0,0 +1,10 @@
+resource "okta_log_stream" "test" {
+  name   = "testAcc_replace_with_uuid_updated"
+  status = "INACTIVE"
+
+  aws_eventbridge {
+    account_id        = "123456789012"
+    event_source_name = "testAcc_replace_with_uuid"
+    region            = "us-east-1"
+  }
+}
@@ -0,0 +1,9 @@
+resource "okta_log_stream" "test" {
+  name = "testAcc_replace_with_uuid"
+
+  splunk_cloud_logstreaming {
+    host    = "acme.splunkcloud.com"
+    edition = "aws"
+    token   = "11111111-1111-1111-1111-111111111111"
+  }
+}
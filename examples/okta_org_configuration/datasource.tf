@@ -0,0 +1 @@
+data "okta_org_configuration" "test" {}
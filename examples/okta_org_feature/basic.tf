@@ -0,0 +1,9 @@
+data "okta_org_feature" "example" {
+  name = "Example Feature"
+}
+
+resource "okta_org_feature" "example" {
+  name    = data.okta_org_feature.example.name
+  enabled = true
+  cascade = true
+}
@@ -0,0 +1,8 @@
+resource "okta_policy_device_assurance_android" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "12"
+  disk_encryption_type    = ["FULL", "USER"]
+  screen_lock_type        = ["BIOMETRIC"]
+  secure_hardware_present = true
+  jailbreak               = false
+}
@@ -0,0 +1,5 @@
+resource "okta_policy_device_assurance_android" "test" {
+  name             = "testAcc_replace_with_uuid_updated"
+  os_version       = "13"
+  screen_lock_type = ["BIOMETRIC", "PASSCODE"]
+}
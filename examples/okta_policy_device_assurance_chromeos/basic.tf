@@ -0,0 +1,11 @@
+resource "okta_policy_device_assurance_chromeos" "test" {
+  name = "testAcc_replace_with_uuid"
+
+  chrome_device_trust {
+    os_version                          = "108.0.5359.172"
+    device_enrollment_domain            = "example.com"
+    key_trust_level                     = "CHROME_BROWSER_HW_KEY"
+    password_protection_warning_trigger = "PHISHING_REUSE"
+    screen_lock_secured                 = true
+  }
+}
@@ -0,0 +1,6 @@
+resource "okta_policy_device_assurance_ios" "test" {
+  name             = "testAcc_replace_with_uuid"
+  os_version       = "16.1"
+  screen_lock_type = ["BIOMETRIC", "PASSCODE"]
+  jailbreak        = false
+}
@@ -0,0 +1,15 @@
+resource "okta_policy_device_assurance_macos" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "13.0.1"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screen_lock_type        = ["PASSCODE"]
+  secure_hardware_present = true
+
+  chrome_device_trust {
+    browser_version                = "106.0.5249.61"
+    disk_encrypted                 = true
+    os_firewall                    = true
+    screen_lock_secured            = true
+    safe_browsing_protection_level = "ENHANCED_PROTECTION"
+  }
+}
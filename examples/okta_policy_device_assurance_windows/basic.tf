@@ -0,0 +1,18 @@
+resource "okta_policy_device_assurance_windows" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "10.0.19045"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  secure_hardware_present = true
+
+  chrome_device_trust {
+    browser_version     = "106.0.5249.61"
+    os_firewall         = true
+    secure_boot_enabled = true
+    windows_user_domain = "example.com"
+  }
+
+  windows_security_center {
+    anti_virus = true
+    firewall   = true
+  }
+}
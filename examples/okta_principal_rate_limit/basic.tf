@@ -0,0 +1,14 @@
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "service"
+  response_types = ["token"]
+  grant_types    = ["client_credentials"]
+  redirect_uris  = ["http://test.com"]
+}
+
+resource "okta_principal_rate_limit" "test" {
+  principal_id                   = okta_app_oauth.test.client_id
+  principal_type                 = "OAUTH_CLIENT"
+  default_percentage             = 50
+  default_concurrency_percentage = 75
+}
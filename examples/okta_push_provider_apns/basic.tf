@@ -0,0 +1,6 @@
+resource "okta_push_provider_apns" "test" {
+  name              = "testAcc_replace_with_uuid"
+  team_id           = "ABCDE12345"
+  key_id            = "ABC123DEFG"
+  token_signing_key = "../examples/okta_push_provider_apns/test_token_signing_key.p8"
+}
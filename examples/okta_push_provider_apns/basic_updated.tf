@@ -0,0 +1,7 @@
+resource "okta_push_provider_apns" "test" {
+  name              = "testAcc_replace_with_uuid_updated"
+  team_id           = "ABCDE12345"
+  key_id            = "DEF456GHIJ"
+  token_signing_key = "../examples/okta_push_provider_apns/test_token_signing_key_rotated.p8"
+  file_name         = "AuthKey_DEF456GHIJ.p8"
+}
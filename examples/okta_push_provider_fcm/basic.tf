@@ -0,0 +1,4 @@
+resource "okta_push_provider_fcm" "test" {
+  name                 = "testAcc_replace_with_uuid"
+  service_account_json = file("../examples/okta_push_provider_fcm/test_service_account.json")
+}
@@ -0,0 +1,4 @@
+resource "okta_realm" "test" {
+  name       = "testAcc_replace_with_uuid"
+  realm_type = "PARTNER"
+}
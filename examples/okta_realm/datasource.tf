@@ -0,0 +1,8 @@
+resource "okta_realm" "test" {
+  name       = "testAcc_replace_with_uuid"
+  realm_type = "PARTNER"
+}
+
+data "okta_realm" "test" {
+  name = okta_realm.test.name
+}
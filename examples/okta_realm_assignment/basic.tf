@@ -0,0 +1,28 @@
+resource "okta_idp_oidc" "test" {
+  name                  = "testAcc_replace_with_uuid"
+  authorization_url     = "https://idp.example.com/authorize"
+  authorization_binding = "HTTP-REDIRECT"
+  token_url             = "https://idp.example.com/token"
+  token_binding         = "HTTP-POST"
+  user_info_url         = "https://idp.example.com/userinfo"
+  user_info_binding     = "HTTP-REDIRECT"
+  jwks_url              = "https://idp.example.com/keys"
+  jwks_binding          = "HTTP-REDIRECT"
+  scopes                = ["openid"]
+  client_id             = "efg456"
+  client_secret         = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+  issuer_url            = "https://id.example.com"
+  username_template     = "idpuser.email"
+}
+
+resource "okta_realm" "test" {
+  name       = "testAcc_replace_with_uuid"
+  realm_type = "PARTNER"
+}
+
+resource "okta_realm_assignment" "test" {
+  name                 = "testAcc_replace_with_uuid"
+  realm_id             = okta_realm.test.id
+  profile_source_id    = okta_idp_oidc.test.id
+  condition_expression = "user.profile.userType == \"contractor\""
+}
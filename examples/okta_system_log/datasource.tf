@@ -0,0 +1,13 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+data "okta_system_log" "test" {
+  filter     = "eventType eq \"user.lifecycle.create\" and target.id eq \"${okta_user.test.id}\""
+  since      = timeadd(timestamp(), "-1h")
+  sort_order = "DESCENDING"
+  max_events = 10
+}
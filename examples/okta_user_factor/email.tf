@@ -0,0 +1,18 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Service"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_factor" "email" {
+  provider_id = "okta_email"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id     = okta_user.test.id
+  factor_type = "email"
+  email       = okta_user.test.email
+  depends_on  = [okta_factor.email]
+}
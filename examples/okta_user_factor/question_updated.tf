@@ -0,0 +1,19 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Service"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_factor" "question" {
+  provider_id = "okta_question"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id     = okta_user.test.id
+  factor_type = "question"
+  question    = "name_of_first_plush_toy"
+  answer      = "teddy bear"
+  depends_on  = [okta_factor.question]
+}
@@ -0,0 +1,18 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Service"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_factor" "sms" {
+  provider_id = "okta_sms"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id      = okta_user.test.id
+  factor_type  = "sms"
+  phone_number = "+15555550100"
+  depends_on   = [okta_factor.sms]
+}
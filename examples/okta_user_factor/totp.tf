@@ -0,0 +1,17 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Service"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_factor" "totp" {
+  provider_id = "okta_otp"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id     = okta_user.test.id
+  factor_type = "token:software:totp"
+  depends_on  = [okta_factor.totp]
+}
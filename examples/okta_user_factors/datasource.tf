@@ -0,0 +1,24 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Service"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_factor" "question" {
+  provider_id = "okta_question"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id     = okta_user.test.id
+  factor_type = "question"
+  question    = "disliked_food"
+  answer      = "meatball"
+  depends_on  = [okta_factor.question]
+}
+
+data "okta_user_factors" "test" {
+  user_id    = okta_user.test.id
+  depends_on = [okta_user_factor.test]
+}
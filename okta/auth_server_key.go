@@ -0,0 +1,81 @@
+package okta
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// authServerKeySchema is the public part of a signing key of an authorization
+// server in JWK format, plus its lifecycle.
+var authServerKeySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"kid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Key ID",
+		},
+		"alg": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Signing algorithm",
+		},
+		"kty": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Key type",
+		},
+		"use": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Intended use of the key",
+		},
+		"e": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "RSA public exponent",
+		},
+		"n": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "RSA modulus",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the key: ACTIVE, NEXT or EXPIRED",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date the key was created",
+		},
+	},
+}
+
+func flattenAuthServerKey(key *okta.JsonWebKey) map[string]interface{} {
+	m := map[string]interface{}{
+		"kid":    key.Kid,
+		"alg":    key.Alg,
+		"kty":    key.Kty,
+		"use":    key.Use,
+		"e":      key.E,
+		"n":      key.N,
+		"status": key.Status,
+	}
+	if key.Created != nil {
+		m["created"] = key.Created.Format(time.RFC3339)
+	}
+	return m
+}
+
+// findAuthServerKey returns nil if no key has the given status
+func findAuthServerKey(keys []*okta.JsonWebKey, status string) *okta.JsonWebKey {
+	for _, key := range keys {
+		if key.Status == status {
+			return key
+		}
+	}
+	return nil
+}
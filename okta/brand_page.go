@@ -0,0 +1,115 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// brandPageSchema is shared by the customizable brand pages, the sign-in page
+// and the error page.
+var brandPageSchema = map[string]*schema.Schema{
+	"brand_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Brand ID",
+	},
+	"preview": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Manage the preview variant of the page instead of the live one. The preview page is only rendered on the brand's preview URL.",
+	},
+	"page_content": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The HTML of the page. The default page content is used when not set.",
+	},
+	"content_security_policy_setting": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Description: "Content Security Policy (CSP) of the page",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "report_only",
+					ValidateDiagFunc: elemInSlice([]string{"enforced", "report_only"}),
+					Description:      "Whether the policy is enforced or only reported. Valid values: `enforced`, `report_only`",
+				},
+				"report_uri": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "URI the CSP violations are reported to",
+				},
+				"src_list": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of trusted sources the page can load resources from",
+				},
+			},
+		},
+	},
+}
+
+// brandPageVariant returns the variant of the page the resource manages
+func brandPageVariant(d *schema.ResourceData) string {
+	if d.Get("preview").(bool) {
+		return sdk.BrandPagePreview
+	}
+	return sdk.BrandPageCustomized
+}
+
+// brandPageContent returns the page content kept in the state. The default
+// content is kept as an empty string unless it is set in the HCL, so that
+// removing 'page_content' resets the page to the default content.
+func brandPageContent(d *schema.ResourceData, content, defaultContent string) string {
+	if content == defaultContent && d.Get("page_content").(string) != content {
+		return ""
+	}
+	return content
+}
+
+// importBrandPage imports a brand page by brand ID, the preview variant of the
+// page is imported with the `<brand_id>/preview` format.
+func importBrandPage(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 || (len(parts) == 2 && parts[1] != sdk.BrandPagePreview) {
+		return nil, fmt.Errorf("invalid ID '%s', expecting the following format <brand_id> or <brand_id>/preview", d.Id())
+	}
+	_ = d.Set("brand_id", parts[0])
+	_ = d.Set("preview", len(parts) == 2)
+	d.SetId(parts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildContentSecurityPolicySetting(d *schema.ResourceData) *sdk.ContentSecurityPolicySetting {
+	if _, ok := d.GetOk("content_security_policy_setting.0"); !ok {
+		return nil
+	}
+	return &sdk.ContentSecurityPolicySetting{
+		Mode:      d.Get("content_security_policy_setting.0.mode").(string),
+		ReportUri: d.Get("content_security_policy_setting.0.report_uri").(string),
+		SrcList:   convertInterfaceToStringSet(d.Get("content_security_policy_setting.0.src_list")),
+	}
+}
+
+func flattenContentSecurityPolicySetting(csp *sdk.ContentSecurityPolicySetting) []interface{} {
+	if csp == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"mode":       csp.Mode,
+		"report_uri": csp.ReportUri,
+		"src_list":   convertStringSliceToSet(csp.SrcList),
+	}}
+}
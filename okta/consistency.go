@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	consistencyInitialInterval = 500 * time.Millisecond
+	consistencyMaxInterval     = 10 * time.Second
+	// consistencyRandomizationFactor spreads the polls of parallel resources,
+	// so they don't hit the rate limits at the same time.
+	consistencyRandomizationFactor = 0.5
+)
+
+var errNotConsistent = errors.New("write is not reflected by the read yet")
+
+// maxWaitSchema returns the schema of the 'max_wait_seconds' attribute of the
+// resources that poll after a write until a read reflects it. It has no
+// default, so that adding it doesn't produce a diff for existing resources;
+// getMaxWait falls back to the default of the resource.
+func maxWaitSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		ValidateDiagFunc: intAtLeast(0),
+		Description:      description,
+	}
+}
+
+// getMaxWait returns the configured 'max_wait_seconds', or defaultWait when
+// it isn't set. Zero disables the polling.
+func getMaxWait(d *schema.ResourceData, defaultWait time.Duration) time.Duration {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return defaultWait
+	}
+	v := raw.GetAttr("max_wait_seconds")
+	if v.IsNull() || !v.IsKnown() {
+		return defaultWait
+	}
+	return time.Duration(d.Get("max_wait_seconds").(int)) * time.Second
+}
+
+// waitForConsistency polls isConsistent with an exponential backoff with
+// jitter until it returns true, maxWait elapses or the context is done.
+// Errors returned by isConsistent stop the polling.
+func waitForConsistency(ctx context.Context, maxWait time.Duration, isConsistent func() (bool, error)) error {
+	if maxWait <= 0 {
+		return nil
+	}
+	bOff := backoff.NewExponentialBackOff()
+	bOff.InitialInterval = consistencyInitialInterval
+	bOff.MaxInterval = consistencyMaxInterval
+	bOff.RandomizationFactor = consistencyRandomizationFactor
+	bOff.MaxElapsedTime = maxWait
+	err := backoff.Retry(func() error {
+		ok, err := isConsistent()
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+		if !ok {
+			return errNotConsistent
+		}
+		return nil
+	}, backoff.WithContext(bOff, ctx))
+	if errors.Is(err, errNotConsistent) {
+		return fmt.Errorf("%w after %s", err, maxWait)
+	}
+	return err
+}
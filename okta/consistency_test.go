@@ -0,0 +1,50 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForConsistency(t *testing.T) {
+	attempts := 0
+	err := waitForConsistency(context.Background(), 10*time.Second, func() (bool, error) {
+		attempts++
+		return attempts == 3, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+
+	attempts = 0
+	checkErr := errors.New("read failed")
+	err = waitForConsistency(context.Background(), 10*time.Second, func() (bool, error) {
+		attempts++
+		return false, checkErr
+	})
+	if !errors.Is(err, checkErr) {
+		t.Errorf("expected the check error, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected the polling to stop after an error, got %d attempts", attempts)
+	}
+
+	err = waitForConsistency(context.Background(), time.Second, func() (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, errNotConsistent) {
+		t.Errorf("expected a timeout error, got: %v", err)
+	}
+
+	err = waitForConsistency(context.Background(), 0, func() (bool, error) {
+		t.Error("expected no polling when the max wait is zero")
+		return false, nil
+	})
+	if err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package okta
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// jsonWebKeySchema is the computed schema of a key credential of an app or of
+// an identity provider.
+var jsonWebKeySchema = map[string]*schema.Schema{
+	"kid": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Key ID",
+	},
+	"kty": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Key type",
+	},
+	"use": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Intended use of the public key",
+	},
+	"created": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date created",
+	},
+	"expires_at": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date the key expires",
+	},
+	"e": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "RSA exponent",
+	},
+	"n": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "RSA modulus",
+	},
+	"x5c": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "base64-encoded X.509 certificate chain with DER encoding",
+	},
+	"x5t_s256": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "base64url-encoded SHA-256 thumbprint of the DER encoding of an X.509 certificate",
+	},
+}
+
+func setJSONWebKey(d *schema.ResourceData, key *okta.JsonWebKey) error {
+	_ = d.Set("kid", key.Kid)
+	_ = d.Set("kty", key.Kty)
+	_ = d.Set("use", key.Use)
+	if key.Created != nil {
+		_ = d.Set("created", key.Created.UTC().String())
+	}
+	if key.ExpiresAt != nil {
+		_ = d.Set("expires_at", key.ExpiresAt.UTC().String())
+	}
+	_ = d.Set("e", key.E)
+	_ = d.Set("n", key.N)
+	_ = d.Set("x5t_s256", key.X5tS256)
+	return setNonPrimitives(d, map[string]interface{}{
+		"x5c": convertStringSliceToInterfaceSlice(key.X5c),
+	})
+}
+
+// csrSchema is the schema of a certificate signing request of an app or of an
+// identity provider, a CSR can't be updated.
+var csrSchema = map[string]*schema.Schema{
+	"subject": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Subject of the certificate",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"common_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Common name",
+				},
+				"country_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Two letter country code",
+				},
+				"state_or_province_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "State or province",
+				},
+				"locality_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Locality",
+				},
+				"organization_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Organization",
+				},
+				"organizational_unit_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Organizational unit",
+				},
+			},
+		},
+	},
+	"dns_names": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "DNS names of the subject alternative names of the certificate",
+	},
+	"csr": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "base64-encoded CSR in DER format",
+	},
+	"csr_pem": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "CSR in PEM format",
+	},
+	"kty": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Key type of the CSR",
+	},
+	"created": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date created",
+	},
+}
+
+func buildCsrMetadata(d *schema.ResourceData) okta.CsrMetadata {
+	metadata := okta.CsrMetadata{
+		Subject: &okta.CsrMetadataSubject{
+			CommonName:             d.Get("subject.0.common_name").(string),
+			CountryName:            d.Get("subject.0.country_name").(string),
+			StateOrProvinceName:    d.Get("subject.0.state_or_province_name").(string),
+			LocalityName:           d.Get("subject.0.locality_name").(string),
+			OrganizationName:       d.Get("subject.0.organization_name").(string),
+			OrganizationalUnitName: d.Get("subject.0.organizational_unit_name").(string),
+		},
+	}
+	if dnsNames := convertInterfaceToStringArrNullable(d.Get("dns_names")); len(dnsNames) > 0 {
+		metadata.SubjectAltNames = &okta.CsrMetadataSubjectAltNames{DnsNames: dnsNames}
+	}
+	return metadata
+}
+
+func setCsr(d *schema.ResourceData, csr *okta.Csr) error {
+	_ = d.Set("csr", csr.Csr)
+	_ = d.Set("kty", csr.Kty)
+	if csr.Created != nil {
+		_ = d.Set("created", csr.Created.UTC().String())
+	}
+	csrPEM, err := csrToPEM(csr.Csr)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("csr_pem", csrPEM)
+	return nil
+}
+
+// csrToPEM converts the base64-encoded DER CSR returned by the API to PEM, the
+// format CAs expect.
+func csrToPEM(csr string) (string, error) {
+	der, err := base64.StdEncoding.DecodeString(csr)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode CSR: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})), nil
+}
@@ -0,0 +1,96 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/okta/internal/jsonpath"
+)
+
+func dataSourceAPIData() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAPIDataRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsAPIPath,
+				Description:      "API path to read, including the query string, e.g. '/api/v1/features'",
+			},
+			"follow_pagination": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read all the pages of a list response",
+			},
+			"jsonpath": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of names to JSONPath expressions evaluated against the response, e.g. '$[*].id'",
+			},
+			"raw_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON response of the API",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Map of names to the values matching the JSONPath expressions",
+			},
+		},
+	}
+}
+
+func dataSourceAPIDataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	path := d.Get("path").(string)
+	data, _, err := getSupplementFromMetadata(m).GetAPIResponse(ctx, path, d.Get("follow_pagination").(bool))
+	if err != nil {
+		return diag.Errorf("failed to get API response: %v", err)
+	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return diag.Errorf("failed to marshal API response: %v", err)
+	}
+	values := make(map[string]interface{})
+	for name, expr := range d.Get("jsonpath").(map[string]interface{}) {
+		matches, err := jsonpath.Get(expr.(string), data)
+		if err != nil {
+			return diag.Errorf("failed to evaluate '%s' JSONPath expression: %v", name, err)
+		}
+		value, err := flattenJSONPathMatches(matches)
+		if err != nil {
+			return diag.Errorf("failed to flatten '%s' JSONPath expression result: %v", name, err)
+		}
+		values[name] = value
+	}
+	d.SetId(path)
+	_ = d.Set("raw_json", string(raw))
+	_ = d.Set("values", values)
+	return nil
+}
+
+// flattenJSONPathMatches returns a single string match as is, any other single
+// match as JSON, and multiple matches as a JSON array.
+func flattenJSONPathMatches(matches []interface{}) (string, error) {
+	var v interface{} = matches
+	switch len(matches) {
+	case 0:
+		return "", nil
+	case 1:
+		if s, ok := matches[0].(string); ok {
+			return s, nil
+		}
+		v = matches[0]
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal matches: %v", err)
+	}
+	return string(b), nil
+}
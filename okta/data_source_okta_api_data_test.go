@@ -0,0 +1,54 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaAPIData_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(apiData)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_api_data.test", "id", "/api/v1/brands"),
+					resource.TestCheckResourceAttrSet("data.okta_api_data.test", "raw_json"),
+					resource.TestCheckResourceAttrSet("data.okta_api_data.test", "values.first_brand_id"),
+					resource.TestCheckResourceAttrSet("data.okta_api_data.test", "values.brand_ids"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenJSONPathMatches(t *testing.T) {
+	tests := []struct {
+		matches  []interface{}
+		expected string
+	}{
+		{nil, ""},
+		{[]interface{}{"ftr1"}, "ftr1"},
+		{[]interface{}{true}, "true"},
+		{[]interface{}{map[string]interface{}{"id": "ftr1"}}, `{"id":"ftr1"}`},
+		{[]interface{}{"ftr1", "ftr2"}, `["ftr1","ftr2"]`},
+	}
+	for _, test := range tests {
+		actual, err := flattenJSONPathMatches(test.matches)
+		if err != nil {
+			t.Errorf("%v returned an error: %v", test.matches, err)
+			continue
+		}
+		if actual != test.expected {
+			t.Errorf("%v expected %q, got %q", test.matches, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAppFeatures() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppFeaturesRead,
+		Description: "Lists the features available for an application.",
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the application",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Features of the application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the feature",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the feature",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the feature",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppFeaturesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	features, _, err := getSupplementFromMetadata(m).ListAppFeatures(ctx, appID)
+	if err != nil {
+		return diag.Errorf("failed to list application features: %v", err)
+	}
+	d.SetId(appID)
+	arr := make([]map[string]interface{}, len(features))
+	for i, feature := range features {
+		arr[i] = map[string]interface{}{
+			"name":        feature.Name,
+			"status":      feature.Status,
+			"description": feature.Description,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"features": arr})
+	if err != nil {
+		return diag.Errorf("failed to set application features: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceAppGroupPushMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppGroupPushMappingsRead,
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the app",
+			},
+			"source_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the mappings of this Okta group",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Only list the mappings with this status",
+			},
+			"mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Group push mappings of the app",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_push": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_summary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppGroupPushMappingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	qp := &query.Params{Limit: defaultPaginationLimit, Status: d.Get("status").(string)}
+	mappings, resp, err := getSupplementFromMetadata(m).ListGroupPushMappings(ctx, appID, d.Get("source_group_id").(string), qp)
+	if err != nil {
+		return diag.Errorf("failed to list group push mappings of app (%s): %v", appID, err)
+	}
+	for resp.HasNextPage() {
+		var nextMappings []*sdk.GroupPushMapping
+		resp, err = resp.Next(ctx, &nextMappings)
+		if err != nil {
+			return diag.Errorf("failed to list group push mappings of app (%s): %v", appID, err)
+		}
+		mappings = append(mappings, nextMappings...)
+	}
+	arr := make([]map[string]interface{}, len(mappings))
+	for i, mapping := range mappings {
+		arr[i] = map[string]interface{}{
+			"id":              mapping.Id,
+			"source_group_id": mapping.SourceGroupId,
+			"target_group_id": mapping.TargetGroupId,
+			"status":          mapping.Status,
+			"last_push":       mapping.LastPush,
+			"error_summary":   mapping.ErrorSummary,
+		}
+	}
+	d.SetId(appID)
+	_ = d.Set("mappings", arr)
+	return nil
+}
@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAuthServerKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthServerKeysRead,
+		Description: "Get the signing keys of an authorization server.",
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Auth server ID",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Signing keys of the authorization server",
+				Elem:        authServerKeySchema,
+			},
+			"jwks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON Web Key Set of the public keys, as served by the keys endpoint of the authorization server",
+			},
+		},
+	}
+}
+
+type authServerJwk struct {
+	Alg string `json:"alg,omitempty"`
+	E   string `json:"e,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Kty string `json:"kty,omitempty"`
+	N   string `json:"n,omitempty"`
+	Use string `json:"use,omitempty"`
+}
+
+func dataSourceAuthServerKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	keys, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServerKeys(ctx, d.Get("auth_server_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to list authorization server keys: %v", err)
+	}
+	arr := make([]interface{}, len(keys))
+	jwks := struct {
+		Keys []authServerJwk `json:"keys"`
+	}{Keys: make([]authServerJwk, len(keys))}
+	for i := range keys {
+		arr[i] = flattenAuthServerKey(keys[i])
+		jwks.Keys[i] = authServerJwk{
+			Alg: keys[i].Alg,
+			E:   keys[i].E,
+			Kid: keys[i].Kid,
+			Kty: keys[i].Kty,
+			N:   keys[i].N,
+			Use: keys[i].Use,
+		}
+	}
+	b, err := json.Marshal(jwks)
+	if err != nil {
+		return diag.Errorf("failed to marshal authorization server keys: %v", err)
+	}
+	d.SetId(d.Get("auth_server_id").(string))
+	_ = d.Set("jwks", string(b))
+	err = setNonPrimitives(d, map[string]interface{}{"keys": arr})
+	if err != nil {
+		return diag.Errorf("failed to set authorization server keys: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaDataSourceAuthServerKeys_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authServerKeys)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", authServerKeys)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.kid"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0.kty", "RSA"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.n"),
+					func(s *terraform.State) error {
+						var jwks struct {
+							Keys []map[string]string `json:"keys"`
+						}
+						err := json.Unmarshal([]byte(s.RootModule().Resources[resourceName].Primary.Attributes["jwks"]), &jwks)
+						if err != nil {
+							return fmt.Errorf("invalid jwks: %v", err)
+						}
+						if len(jwks.Keys) == 0 || jwks.Keys[0]["kid"] == "" {
+							return fmt.Errorf("expected jwks to list the keys, got %v", jwks)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
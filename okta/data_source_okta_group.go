@@ -51,6 +51,7 @@ func dataSourceGroup() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Force delay of the group read by N seconds. Useful when eventual consistency of group information needs to be allowed for; for instance, when group rules are known to have been applied.",
+				Deprecated:  "`delay_read_seconds` is deprecated, `okta_group_rule` resources can wait until their groups have members, see its `max_wait_seconds` argument",
 			},
 		},
 	}
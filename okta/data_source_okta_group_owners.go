@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGroupOwners() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGroupOwnersRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the group",
+			},
+			"owners": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Owners of the group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the owner",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the owner: USER or GROUP",
+						},
+						"display_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display name of the owner",
+						},
+						"origin_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the owner in the source the owner is mastered by",
+						},
+						"origin_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Source the owner is mastered by, e.g. OKTA_DIRECTORY or APPLICATION",
+						},
+						"resolved": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the owner is resolved to an Okta user or group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupOwnersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	owners, _, err := getSupplementFromMetadata(m).ListGroupOwners(ctx, groupID, nil)
+	if err != nil {
+		return diag.Errorf("failed to list group owners: %v", err)
+	}
+	arr := make([]map[string]interface{}, len(owners))
+	for i, owner := range owners {
+		arr[i] = map[string]interface{}{
+			"id":           owner.Id,
+			"type":         owner.Type,
+			"display_name": owner.DisplayName,
+			"origin_id":    owner.OriginId,
+			"origin_type":  owner.OriginType,
+		}
+		if owner.Resolved != nil {
+			arr[i]["resolved"] = *owner.Resolved
+		}
+	}
+	d.SetId(groupID)
+	_ = d.Set("owners", arr)
+	return nil
+}
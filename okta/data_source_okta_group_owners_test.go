@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaGroupOwners_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupOwners)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", groupOwners)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "owners.0.type", "USER"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "owners.0.display_name"),
+				),
+			},
+		},
+	})
+}
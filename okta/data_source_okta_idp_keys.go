@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIdpKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIdpKeysRead,
+		Schema: map[string]*schema.Schema{
+			"idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the identity provider",
+			},
+			"active_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only list the ACTIVE signing keys",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Signing keys of the identity provider",
+				Elem: &schema.Resource{
+					Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the key",
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIdpKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	idpID := d.Get("idp_id").(string)
+	keys, _, err := getOktaClientFromMetadata(m).IdentityProvider.ListIdentityProviderSigningKeys(ctx, idpID)
+	if err != nil {
+		return diag.Errorf("failed to list identity provider signing keys: %v", err)
+	}
+	activeOnly := d.Get("active_only").(bool)
+	arr := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if activeOnly && key.Status != statusActive {
+			continue
+		}
+		k := map[string]interface{}{
+			"kid":      key.Kid,
+			"kty":      key.Kty,
+			"use":      key.Use,
+			"status":   key.Status,
+			"e":        key.E,
+			"n":        key.N,
+			"x5c":      convertStringSliceToInterfaceSlice(key.X5c),
+			"x5t_s256": key.X5tS256,
+		}
+		if key.Created != nil {
+			k["created"] = key.Created.UTC().String()
+		}
+		if key.ExpiresAt != nil {
+			k["expires_at"] = key.ExpiresAt.UTC().String()
+		}
+		arr = append(arr, k)
+	}
+	d.SetId(idpID)
+	_ = d.Set("keys", arr)
+	return nil
+}
@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceIdpKeys_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(idpKeys)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", idpKeys)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_idp_saml.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]string{"kty": "RSA"}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "keys.*.kid", "okta_idp_key.test", "id"),
+				),
+			},
+		},
+	})
+}
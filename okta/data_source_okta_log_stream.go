@@ -0,0 +1,107 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceLogStream() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLogStreamRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the log stream",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the log stream",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the log stream",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the log stream",
+			},
+			sdk.LogStreamTypeAWSEventBridge: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of an AWS EventBridge log stream",
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"account_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"event_source_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"region": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				}},
+			},
+			sdk.LogStreamTypeSplunkCloud: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Settings of a Splunk Cloud log stream, the token is not returned by the API",
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"host": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"edition": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceLogStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var stream *sdk.LogStream
+	if id, ok := d.GetOk("id"); ok {
+		respStream, _, err := getSupplementFromMetadata(m).GetLogStream(ctx, id.(string))
+		if err != nil {
+			return diag.Errorf("failed to get log stream by ID: %v", err)
+		}
+		stream = respStream
+	} else {
+		name := d.Get("name").(string)
+		streams, _, err := getSupplementFromMetadata(m).ListLogStreams(ctx, nil)
+		if err != nil {
+			return diag.Errorf("failed to list log streams: %v", err)
+		}
+		for _, s := range streams {
+			if s.Name == name {
+				stream = s
+				break
+			}
+		}
+		if stream == nil {
+			return diag.Errorf("log stream with name '%s' does not exist", name)
+		}
+	}
+	d.SetId(stream.Id)
+	_ = d.Set("name", stream.Name)
+	_ = d.Set("type", stream.Type)
+	_ = d.Set("status", stream.Status)
+	err := setNonPrimitives(d, flattenLogStreamSettings(stream, ""))
+	if err != nil {
+		return diag.Errorf("failed to set log stream settings: %v", err)
+	}
+	return nil
+}
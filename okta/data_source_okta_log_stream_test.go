@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccDataSourceOktaLogStream_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_log_stream.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", sdk.LogStreamTypeAWSEventBridge),
+					resource.TestCheckResourceAttr(dataSourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(dataSourceName, "aws_eventbridge.0.account_id", "123456789012"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgConfigurationRead,
+		Description: "Get the settings of the org.",
+		Schema: map[string]*schema.Schema{
+			"company_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of org",
+			},
+			"website": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The org's website",
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Phone number of org",
+			},
+			"end_user_support_help_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Support link of org",
+			},
+			"support_phone_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Support help phone of org",
+			},
+			"address_1": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Primary address of org",
+			},
+			"address_2": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secondary address of org",
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "City of org",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of org",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Country of org",
+			},
+			"postal_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Postal code of org",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration of org",
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subdomain of org",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of org",
+			},
+			"billing_contact_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User ID representing the billing contact",
+			},
+			"technical_contact_user": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User ID representing the technical contact",
+			},
+		},
+	}
+}
+
+func dataSourceOrgConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	settings, _, err := client.OrgSetting.GetOrgSettings(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get org settings: %v", err)
+	}
+	d.SetId(settings.Id)
+	setOrgSettings(d, settings)
+	_ = d.Set("status", settings.Status)
+	billingContact, _, err := client.OrgSetting.GetOrgContactUser(ctx, "BILLING")
+	if err != nil {
+		return diag.Errorf("failed to get billing contact user: %v", err)
+	}
+	_ = d.Set("billing_contact_user", billingContact.UserId)
+	technicalContact, _, err := client.OrgSetting.GetOrgContactUser(ctx, "TECHNICAL")
+	if err != nil {
+		return diag.Errorf("failed to get technical contact user: %v", err)
+	}
+	_ = d.Set("technical_contact_user", technicalContact.UserId)
+	return nil
+}
@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceOrgConfiguration_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(orgConfiguration)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", orgConfiguration)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "company_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "subdomain", os.Getenv("OKTA_ORG_NAME")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func dataSourceOrgFeature() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgFeatureRead,
+		Description: "Get a self-service feature of the org by its ID or its name.",
+		Schema: buildSchema(orgFeatureComputedSchema, map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the feature",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "Name of the feature",
+			},
+		}),
+	}
+}
+
+func dataSourceOrgFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var (
+		feature *okta.Feature
+		err     error
+	)
+	if id, ok := d.GetOk("id"); ok {
+		feature, _, err = getOktaClientFromMetadata(m).Feature.GetFeature(ctx, id.(string))
+		if err != nil {
+			return diag.Errorf("failed to get org feature: %v", err)
+		}
+	} else {
+		feature, err = findOrgFeatureByName(ctx, m, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(feature.Id)
+	if err := setOrgFeature(ctx, d, m, feature); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrgMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgMetadataRead,
+		Description: "Get the well-known metadata of the org.",
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Authentication pipeline of the org, 'v1' for Classic Engine and 'idx' for Identity Engine",
+			},
+			"organization_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Okta URL of the org",
+			},
+			"alternate_urls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "URLs of the custom domains of the org",
+			},
+		},
+	}
+}
+
+func dataSourceOrgMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	org, _, err := getSupplementFromMetadata(m).GetWellKnownOktaOrganization(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get org metadata: %v", err)
+	}
+	d.SetId(org.Id)
+	_ = d.Set("pipeline", org.Pipeline)
+	organizationURL, alternateURLs := flattenOrgMetadataLinks(org.Links)
+	_ = d.Set("organization_url", organizationURL)
+	_ = d.Set("alternate_urls", alternateURLs)
+	return nil
+}
+
+// flattenOrgMetadataLinks returns the organization URL and the alternate
+// (custom domain) URLs of the well-known org links. The "alternate" link is a
+// single object when the org has one custom domain and a list when it has more.
+func flattenOrgMetadataLinks(links interface{}) (string, []string) {
+	linksMap, ok := links.(map[string]interface{})
+	if !ok {
+		return "", []string{}
+	}
+	var organizationURL string
+	if org, ok := linksMap["organization"].(map[string]interface{}); ok {
+		organizationURL, _ = org["href"].(string)
+	}
+	alternateURLs := []string{}
+	var alternates []interface{}
+	switch v := linksMap["alternate"].(type) {
+	case map[string]interface{}:
+		alternates = []interface{}{v}
+	case []interface{}:
+		alternates = v
+	}
+	for _, alternate := range alternates {
+		if link, ok := alternate.(map[string]interface{}); ok {
+			if href, ok := link["href"].(string); ok && href != "" {
+				alternateURLs = append(alternateURLs, href)
+			}
+		}
+	}
+	return organizationURL, alternateURLs
+}
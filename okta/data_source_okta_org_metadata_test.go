@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOktaDataSourceOrgMetadata_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(orgMetadata)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", orgMetadata)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "pipeline", regexp.MustCompile(`^(v1|idx)$`)),
+					resource.TestCheckResourceAttr(dataSourceName, "organization_url",
+						fmt.Sprintf("https://%s.%s", os.Getenv("OKTA_ORG_NAME"), os.Getenv("OKTA_BASE_URL"))),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenOrgMetadataLinks(t *testing.T) {
+	tests := []struct {
+		name         string
+		links        interface{}
+		organization string
+		alternates   []string
+	}{
+		{
+			name:       "no links",
+			links:      nil,
+			alternates: []string{},
+		},
+		{
+			name: "no custom domain",
+			links: map[string]interface{}{
+				"organization": map[string]interface{}{"href": "https://example.okta.com"},
+			},
+			organization: "https://example.okta.com",
+			alternates:   []string{},
+		},
+		{
+			name: "one custom domain",
+			links: map[string]interface{}{
+				"organization": map[string]interface{}{"href": "https://example.okta.com"},
+				"alternate":    map[string]interface{}{"href": "https://login.example.com"},
+			},
+			organization: "https://example.okta.com",
+			alternates:   []string{"https://login.example.com"},
+		},
+		{
+			name: "many custom domains",
+			links: map[string]interface{}{
+				"organization": map[string]interface{}{"href": "https://example.okta.com"},
+				"alternate": []interface{}{
+					map[string]interface{}{"href": "https://login.example.com"},
+					map[string]interface{}{"href": "https://id.example.com"},
+				},
+			},
+			organization: "https://example.okta.com",
+			alternates:   []string{"https://login.example.com", "https://id.example.com"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			organization, alternates := flattenOrgMetadataLinks(test.links)
+			assert.Equal(t, test.organization, organization)
+			assert.Equal(t, test.alternates, alternates)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceRealm() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRealmRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"id", "name"},
+				Description:  "ID of the realm",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the realm",
+			},
+			"realm_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the realm",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the realm is the default realm of the org",
+			},
+		},
+	}
+}
+
+func dataSourceRealmRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return datasourceOIEOnlyFeatureError(realm)
+	}
+	var r *sdk.Realm
+	if id, ok := d.GetOk("id"); ok {
+		respRealm, _, err := getSupplementFromMetadata(m).GetRealm(ctx, id.(string))
+		if err != nil {
+			return diag.Errorf("failed to get realm by ID: %v", err)
+		}
+		r = respRealm
+	} else {
+		name := d.Get("name").(string)
+		realms, _, err := getSupplementFromMetadata(m).ListRealms(ctx, nil)
+		if err != nil {
+			return diag.Errorf("failed to list realms: %v", err)
+		}
+		for _, candidate := range realms {
+			if candidate.Profile != nil && candidate.Profile.Name == name {
+				r = candidate
+				break
+			}
+		}
+		if r == nil {
+			return diag.Errorf("realm with name '%s' does not exist", name)
+		}
+	}
+	d.SetId(r.Id)
+	_ = d.Set("is_default", r.IsDefault)
+	if r.Profile != nil {
+		_ = d.Set("name", r.Profile.Name)
+		_ = d.Set("realm_type", r.Profile.RealmType)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccDataSourceOktaRealm_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(realm)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", realm)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "okta_realm.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "realm_type", sdk.RealmTypePartner),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", "false"),
+				),
+			},
+		},
+	})
+}
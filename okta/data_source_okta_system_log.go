@@ -0,0 +1,285 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// systemLogPageLimit is the maximum number of events the System Log API
+// returns per page.
+const systemLogPageLimit = 1000
+
+var systemLogEntitySchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"alternate_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func dataSourceSystemLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSystemLogRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SCIM filter expression the events should match, e.g. 'eventType eq \"user.session.start\"'",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Keywords the events should contain",
+			},
+			"since": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsRFC3339,
+				Description:      "Only return events published at or after this RFC3339 timestamp. The API defaults to 7 days ago",
+			},
+			"until": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsRFC3339,
+				Description:      "Only return events published before this RFC3339 timestamp",
+			},
+			"sort_order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ASCENDING",
+				ValidateDiagFunc: elemInSlice([]string{"ASCENDING", "DESCENDING"}),
+				Description:      "Order of the events by their publish time: ASCENDING or DESCENDING",
+			},
+			"max_events": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: intBetween(1, 10000),
+				Description:      "Maximum number of events to return, the pagination stops once it is reached",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Events matching the query",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"published": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"legacy_event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transaction_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Resource{Schema: systemLogEntitySchema},
+						},
+						"targets": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Resource{Schema: systemLogEntitySchema},
+						},
+						"outcome": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"result": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"client": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"device": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"user_agent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"browser": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"os": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"city": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"raw_json": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "JSON of the event as returned by the API",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSystemLogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	maxEvents := d.Get("max_events").(int)
+	qp := &query.Params{
+		Filter:    d.Get("filter").(string),
+		Q:         d.Get("q").(string),
+		Since:     d.Get("since").(string),
+		Until:     d.Get("until").(string),
+		SortOrder: d.Get("sort_order").(string),
+		Limit:     int64(maxEvents),
+	}
+	if qp.Limit > systemLogPageLimit {
+		qp.Limit = systemLogPageLimit
+	}
+	// the requests are made with the provider's HTTP client, so they are
+	// throttled by the 'max_api_capacity' governor like any other request
+	rawEvents, _, err := getSupplementFromMetadata(m).ListLogEvents(ctx, qp, maxEvents)
+	if err != nil {
+		return diag.Errorf("failed to list system log events: %v", err)
+	}
+	events := make([]interface{}, len(rawEvents))
+	for i, raw := range rawEvents {
+		var event okta.LogEvent
+		if err := json.Unmarshal(raw, &event); err != nil {
+			return diag.Errorf("failed to unmarshal system log event: %v", err)
+		}
+		flattened := flattenLogEvent(&event)
+		flattened["raw_json"] = string(raw)
+		events[i] = flattened
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	_ = d.Set("events", events)
+	return nil
+}
+
+func flattenLogEvent(event *okta.LogEvent) map[string]interface{} {
+	m := map[string]interface{}{
+		"uuid":              event.Uuid,
+		"event_type":        event.EventType,
+		"legacy_event_type": event.LegacyEventType,
+		"display_message":   event.DisplayMessage,
+		"severity":          event.Severity,
+	}
+	if event.Published != nil {
+		m["published"] = event.Published.Format(time.RFC3339Nano)
+	}
+	if event.Transaction != nil {
+		m["transaction_id"] = event.Transaction.Id
+	}
+	if event.Actor != nil {
+		m["actor"] = []interface{}{flattenLogEntity(event.Actor.Id, event.Actor.Type, event.Actor.AlternateId, event.Actor.DisplayName)}
+	}
+	targets := make([]interface{}, len(event.Target))
+	for i, target := range event.Target {
+		targets[i] = flattenLogEntity(target.Id, target.Type, target.AlternateId, target.DisplayName)
+	}
+	m["targets"] = targets
+	if event.Outcome != nil {
+		m["outcome"] = []interface{}{map[string]interface{}{
+			"result": event.Outcome.Result,
+			"reason": event.Outcome.Reason,
+		}}
+	}
+	if event.Client != nil {
+		client := map[string]interface{}{
+			"ip_address": event.Client.IpAddress,
+			"device":     event.Client.Device,
+			"zone":       event.Client.Zone,
+		}
+		if ua := event.Client.UserAgent; ua != nil {
+			client["user_agent"] = ua.RawUserAgent
+			client["browser"] = ua.Browser
+			client["os"] = ua.Os
+		}
+		if geo := event.Client.GeographicalContext; geo != nil {
+			client["city"] = geo.City
+			client["state"] = geo.State
+			client["country"] = geo.Country
+		}
+		m["client"] = []interface{}{client}
+	}
+	return m
+}
+
+func flattenLogEntity(id, typ, alternateID, displayName string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":           id,
+		"type":         typ,
+		"alternate_id": alternateID,
+		"display_name": displayName,
+	}
+}
@@ -0,0 +1,80 @@
+package okta
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccDataSourceOktaSystemLog_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(systemLog)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	dataSourceName := fmt.Sprintf("data.%s.test", systemLog)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "sort_order", "DESCENDING"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenLogEvent(t *testing.T) {
+	raw := `{
+  "uuid": "dc9fd3c0-598c-11ef-8478-2b7584bf8d5a",
+  "published": "2022-11-08T12:00:00.000Z",
+  "eventType": "user.session.start",
+  "displayMessage": "User login to Okta",
+  "severity": "INFO",
+  "actor": {"id": "00u1", "type": "User", "alternateId": "john@example.com", "displayName": "John Smith"},
+  "client": {
+    "ipAddress": "10.0.0.1",
+    "userAgent": {"rawUserAgent": "Mozilla/5.0", "browser": "CHROME", "os": "Mac OS X"},
+    "geographicalContext": {"city": "San Francisco", "state": "California", "country": "United States"}
+  },
+  "outcome": {"result": "FAILURE", "reason": "INVALID_CREDENTIALS"},
+  "target": [{"id": "0oa1", "type": "AppInstance", "alternateId": "Example App", "displayName": "Example App"}],
+  "transaction": {"id": "Y2xn"}
+}`
+	var event okta.LogEvent
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		t.Fatalf("failed to unmarshal event: %v", err)
+	}
+	m := flattenLogEvent(&event)
+	if m["published"] != "2022-11-08T12:00:00Z" {
+		t.Errorf("unexpected published: %v", m["published"])
+	}
+	if m["transaction_id"] != "Y2xn" {
+		t.Errorf("unexpected transaction_id: %v", m["transaction_id"])
+	}
+	actor := m["actor"].([]interface{})[0].(map[string]interface{})
+	if actor["alternate_id"] != "john@example.com" {
+		t.Errorf("unexpected actor: %v", actor)
+	}
+	outcome := m["outcome"].([]interface{})[0].(map[string]interface{})
+	if outcome["result"] != "FAILURE" || outcome["reason"] != "INVALID_CREDENTIALS" {
+		t.Errorf("unexpected outcome: %v", outcome)
+	}
+	client := m["client"].([]interface{})[0].(map[string]interface{})
+	if client["browser"] != "CHROME" || client["city"] != "San Francisco" {
+		t.Errorf("unexpected client: %v", client)
+	}
+	if targets := m["targets"].([]interface{}); len(targets) != 1 {
+		t.Errorf("expected 1 target, got %d", len(targets))
+	}
+}
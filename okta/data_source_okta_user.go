@@ -83,6 +83,7 @@ func dataSourceUser() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Force delay of the user read by N seconds. Useful when eventual consistency of user information needs to be allowed for.",
+				Deprecated:  "`delay_read_seconds` is deprecated, `okta_user` resources now wait until they are returned by user searches, see its `max_wait_seconds` argument",
 			},
 		}),
 	}
@@ -0,0 +1,84 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceUserFactors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserFactorsRead,
+		Description: "Get the factors enrolled by a user.",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of a Okta User",
+			},
+			"factors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"factor_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"provider_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"profile": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserFactorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	factors, _, err := getSupplementFromMetadata(m).ListUserFactors(ctx, d.Get("user_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to list factors of '%s' user: %v", d.Get("user_id").(string), err)
+	}
+	arr := make([]map[string]interface{}, len(factors))
+	for i := range factors {
+		profile := map[string]interface{}{}
+		for k, v := range factors[i].Profile {
+			switch v.(type) {
+			case string, bool, float64:
+				profile[k] = fmt.Sprint(v)
+			}
+		}
+		arr[i] = map[string]interface{}{
+			"id":          factors[i].Id,
+			"factor_type": factors[i].FactorType,
+			"provider_id": factors[i].Provider,
+			"vendor_name": factors[i].VendorName,
+			"status":      factors[i].Status,
+			"profile":     profile,
+		}
+	}
+	d.SetId(d.Get("user_id").(string))
+	_ = d.Set("factors", arr)
+	return nil
+}
@@ -0,0 +1,33 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceUserFactors_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactors)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", userFactors)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "factors.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "factors.0.id", "okta_user_factor.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "factors.0.factor_type", "question"),
+					resource.TestCheckResourceAttr(resourceName, "factors.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "factors.0.profile.question", "disliked_food"),
+				),
+			},
+		},
+	})
+}
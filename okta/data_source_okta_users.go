@@ -68,6 +68,7 @@ func dataSourceUsers() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "Force delay of the users read by N seconds. Useful when eventual consistency of users information needs to be allowed for.",
+				Deprecated:  "`delay_read_seconds` is deprecated, `okta_user` resources now wait until they are returned by user searches, see its `max_wait_seconds` argument",
 			},
 		},
 	}
@@ -216,6 +216,44 @@ func TestAccDataSourceOktaUsers_IncludeAll(t *testing.T) {
 	})
 }
 
+// TestAccOktaDataSourceUsers_readAfterCreate searches a user in the same apply
+// as it is created, without 'delay_read_seconds': the user resource waits
+// until searches return it.
+func TestAccOktaDataSourceUsers_readAfterCreate(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(users)
+	config := `
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+data "okta_users" "test" {
+  search {
+    name       = "profile.login"
+    comparison = "eq"
+    value      = okta_user.test.login
+  }
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: mgr.ConfigReplace(config, ri),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_users.test", "users.0.id", "okta_user.test", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testOktaUsersRolesGroupsConfig(includeGroups, includeRoles bool) string {
 	prepend := `
 resource "okta_group" "testAcc-replace_with_uuid" {
@@ -0,0 +1,330 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// The device assurance resources live in resource_okta_policy_device_assurance_<platform>_platform.go
+// files since a plain _android, _ios or _windows suffix is a GOOS build constraint.
+
+// Basis of device assurance policy schema
+var (
+	baseDeviceAssuranceSchema = map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the device assurance policy",
+		},
+		"platform": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Platform of the device assurance policy",
+		},
+	}
+
+	deviceAssuranceOsVersionSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Minimum OS version of the device, e.g. '12.4.5'",
+	}
+
+	deviceAssuranceSecureHardwareSchema = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the device must have secure hardware, e.g. a TPM or a Secure Enclave. Not checked if unset.",
+	}
+
+	deviceAssuranceJailbreakSchema = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the device is allowed to be jailbroken or rooted, set it to false to only allow devices which aren't. Not checked if unset.",
+	}
+
+	chromeDeviceTrustSchema = map[string]*schema.Schema{
+		"browser_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Minimum Chrome version, e.g. '106.0.5249.61'",
+		},
+		"builtin_dns_client_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the Chrome built-in DNS client must be enabled",
+		},
+		"chrome_remote_desktop_app_blocked": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the Chrome Remote Desktop app must be blocked",
+		},
+		"device_enrollment_domain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Domain the device must be enrolled in",
+		},
+		"disk_encrypted": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the disk of the device must be encrypted",
+		},
+		"key_trust_level": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: elemInSlice([]string{"CHROME_BROWSER_HW_KEY", "CHROME_BROWSER_OS_KEY"}),
+			Description:      "Trust level of the key Chrome signs the signals with: CHROME_BROWSER_HW_KEY or CHROME_BROWSER_OS_KEY",
+		},
+		"os_firewall": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the OS firewall must be enabled",
+		},
+		"os_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Minimum OS version reported by Chrome",
+		},
+		"password_protection_warning_trigger": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: elemInSlice([]string{"PASSWORD_PROTECTION_OFF", "PASSWORD_REUSE", "PHISHING_REUSE"}),
+			Description:      "Chrome password protection warning trigger: PASSWORD_PROTECTION_OFF, PASSWORD_REUSE or PHISHING_REUSE",
+		},
+		"realtime_url_check_mode": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether Chrome real-time URL checks must be enabled",
+		},
+		"safe_browsing_protection_level": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: elemInSlice([]string{"DISABLED", "STANDARD_PROTECTION", "ENHANCED_PROTECTION"}),
+			Description:      "Minimum Chrome Safe Browsing protection level: DISABLED, STANDARD_PROTECTION or ENHANCED_PROTECTION",
+		},
+		"screen_lock_secured": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the screen lock of the device must be secured",
+		},
+		"site_isolation_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether Chrome site isolation must be enabled",
+		},
+	}
+)
+
+func buildDeviceAssuranceSchema(target map[string]*schema.Schema) map[string]*schema.Schema {
+	return buildSchema(baseDeviceAssuranceSchema, target)
+}
+
+// chromeDeviceTrustBlockSchema returns the Chrome Device Trust signal provider
+// block, extended with the signals only reported on some platforms.
+func chromeDeviceTrustBlockSchema(extra map[string]*schema.Schema) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Signals reported by the Chrome Device Trust connector",
+		Elem:        &schema.Resource{Schema: buildSchema(chromeDeviceTrustSchema, extra)},
+	}
+}
+
+func deviceAssuranceIncludeSchema(description string, values []string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: elemInSlice(values),
+		},
+	}
+}
+
+func createDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, assurance sdk.DeviceAssurance) error {
+	logger(m).Info("creating device assurance policy", "name", assurance.Name, "platform", assurance.Platform)
+	created, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, assurance)
+	if err != nil {
+		return fmt.Errorf("failed to create device assurance policy: %v", err)
+	}
+	d.SetId(created.Id)
+	return nil
+}
+
+// getDeviceAssurance returns the device assurance policy and sets its common
+// attributes, or returns nil when it was removed.
+func getDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, platform string) (*sdk.DeviceAssurance, error) {
+	assurance, resp, err := getSupplementFromMetadata(m).GetDeviceAssurance(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get device assurance policy: %v", err)
+	}
+	if assurance == nil {
+		d.SetId("")
+		return nil, nil
+	}
+	if assurance.Platform != platform {
+		return nil, fmt.Errorf("device assurance policy '%s' is for the '%s' platform, not '%s'", d.Id(), assurance.Platform, platform)
+	}
+	_ = d.Set("name", assurance.Name)
+	_ = d.Set("platform", assurance.Platform)
+	return assurance, nil
+}
+
+func updateDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}, assurance sdk.DeviceAssurance) error {
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), assurance)
+	if err != nil {
+		return fmt.Errorf("failed to update device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func deleteDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	resp, err := getSupplementFromMetadata(m).DeleteDeviceAssurance(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to delete device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func buildDiskEncryptionType(d *schema.ResourceData) *sdk.DeviceAssuranceDiskEncryptionType {
+	if v, ok := d.GetOk("disk_encryption_type"); ok {
+		return &sdk.DeviceAssuranceDiskEncryptionType{Include: convertInterfaceToStringSet(v)}
+	}
+	return nil
+}
+
+func flattenDiskEncryptionType(diskEncryptionType *sdk.DeviceAssuranceDiskEncryptionType) *schema.Set {
+	if diskEncryptionType == nil {
+		return nil
+	}
+	return convertStringSliceToSetNullable(diskEncryptionType.Include)
+}
+
+func buildScreenLockType(d *schema.ResourceData) *sdk.DeviceAssuranceScreenLockType {
+	if v, ok := d.GetOk("screen_lock_type"); ok {
+		return &sdk.DeviceAssuranceScreenLockType{Include: convertInterfaceToStringSet(v)}
+	}
+	return nil
+}
+
+func flattenScreenLockType(screenLockType *sdk.DeviceAssuranceScreenLockType) *schema.Set {
+	if screenLockType == nil {
+		return nil
+	}
+	return convertStringSliceToSetNullable(screenLockType.Include)
+}
+
+func buildDeviceAssuranceOsVersion(d *schema.ResourceData) *sdk.DeviceAssuranceOsVersion {
+	if v, ok := d.GetOk("os_version"); ok {
+		return &sdk.DeviceAssuranceOsVersion{Minimum: v.(string)}
+	}
+	return nil
+}
+
+func flattenDeviceAssuranceOsVersion(osVersion *sdk.DeviceAssuranceOsVersion) string {
+	if osVersion == nil {
+		return ""
+	}
+	return osVersion.Minimum
+}
+
+// configBoolPtr returns the value of a top level boolean signal set in the
+// HCL, false is a valid value for signals like 'jailbreak'. It returns nil
+// when the signal isn't set, so it isn't checked.
+func configBoolPtr(d *schema.ResourceData, key string) *bool {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return nil
+	}
+	return boolPtr(d.Get(key).(bool))
+}
+
+// optionalBoolPtr returns nil for false, since the API treats a missing signal
+// as not being checked.
+func optionalBoolPtr(v interface{}) *bool {
+	if b, ok := v.(bool); ok && b {
+		return boolPtr(true)
+	}
+	return nil
+}
+
+func flattenOptionalBool(b *bool) bool {
+	return b != nil && *b
+}
+
+func buildChromeDeviceTrust(d *schema.ResourceData) *sdk.DeviceAssuranceChromeDeviceTrust {
+	raw, ok := d.GetOk("chrome_device_trust")
+	if !ok || len(raw.([]interface{})) == 0 || raw.([]interface{})[0] == nil {
+		return nil
+	}
+	v := raw.([]interface{})[0].(map[string]interface{})
+	dtc := &sdk.DeviceAssuranceChromeDeviceTrust{
+		BuiltInDnsClientEnabled:          optionalBoolPtr(v["builtin_dns_client_enabled"]),
+		ChromeRemoteDesktopAppBlocked:    optionalBoolPtr(v["chrome_remote_desktop_app_blocked"]),
+		DeviceEnrollmentDomain:           v["device_enrollment_domain"].(string),
+		DiskEncrypted:                    optionalBoolPtr(v["disk_encrypted"]),
+		KeyTrustLevel:                    v["key_trust_level"].(string),
+		OsFirewall:                       optionalBoolPtr(v["os_firewall"]),
+		PasswordProtectionWarningTrigger: v["password_protection_warning_trigger"].(string),
+		RealtimeUrlCheckMode:             optionalBoolPtr(v["realtime_url_check_mode"]),
+		SafeBrowsingProtectionLevel:      v["safe_browsing_protection_level"].(string),
+		ScreenLockSecured:                optionalBoolPtr(v["screen_lock_secured"]),
+		SiteIsolationEnabled:             optionalBoolPtr(v["site_isolation_enabled"]),
+		CrowdStrikeAgentId:               getMapString(v, "crowd_strike_agent_id"),
+		CrowdStrikeCustomerId:            getMapString(v, "crowd_strike_customer_id"),
+		SecureBootEnabled:                optionalBoolPtr(v["secure_boot_enabled"]),
+		ThirdPartyBlockingEnabled:        optionalBoolPtr(v["third_party_blocking_enabled"]),
+		WindowsMachineDomain:             getMapString(v, "windows_machine_domain"),
+		WindowsUserDomain:                getMapString(v, "windows_user_domain"),
+	}
+	if browserVersion := v["browser_version"].(string); browserVersion != "" {
+		dtc.BrowserVersion = &sdk.DeviceAssuranceOsVersion{Minimum: browserVersion}
+	}
+	if osVersion := v["os_version"].(string); osVersion != "" {
+		dtc.OsVersion = &sdk.DeviceAssuranceOsVersion{Minimum: osVersion}
+	}
+	return dtc
+}
+
+// flattenChromeDeviceTrust returns the chrome_device_trust block, the Windows
+// only signals are set when windows is true. The block is empty when the policy
+// doesn't check Chrome Device Trust signals.
+func flattenChromeDeviceTrust(dtc *sdk.DeviceAssuranceChromeDeviceTrust, windows bool) []interface{} {
+	if dtc == nil {
+		return []interface{}{}
+	}
+	v := map[string]interface{}{
+		"browser_version":                     flattenDeviceAssuranceOsVersion(dtc.BrowserVersion),
+		"builtin_dns_client_enabled":          flattenOptionalBool(dtc.BuiltInDnsClientEnabled),
+		"chrome_remote_desktop_app_blocked":   flattenOptionalBool(dtc.ChromeRemoteDesktopAppBlocked),
+		"device_enrollment_domain":            dtc.DeviceEnrollmentDomain,
+		"disk_encrypted":                      flattenOptionalBool(dtc.DiskEncrypted),
+		"key_trust_level":                     dtc.KeyTrustLevel,
+		"os_firewall":                         flattenOptionalBool(dtc.OsFirewall),
+		"os_version":                          flattenDeviceAssuranceOsVersion(dtc.OsVersion),
+		"password_protection_warning_trigger": dtc.PasswordProtectionWarningTrigger,
+		"realtime_url_check_mode":             flattenOptionalBool(dtc.RealtimeUrlCheckMode),
+		"safe_browsing_protection_level":      dtc.SafeBrowsingProtectionLevel,
+		"screen_lock_secured":                 flattenOptionalBool(dtc.ScreenLockSecured),
+		"site_isolation_enabled":              flattenOptionalBool(dtc.SiteIsolationEnabled),
+	}
+	if windows {
+		v["crowd_strike_agent_id"] = dtc.CrowdStrikeAgentId
+		v["crowd_strike_customer_id"] = dtc.CrowdStrikeCustomerId
+		v["secure_boot_enabled"] = flattenOptionalBool(dtc.SecureBootEnabled)
+		v["third_party_blocking_enabled"] = flattenOptionalBool(dtc.ThirdPartyBlockingEnabled)
+		v["windows_machine_domain"] = dtc.WindowsMachineDomain
+		v["windows_user_domain"] = dtc.WindowsUserDomain
+	}
+	return []interface{}{v}
+}
@@ -0,0 +1,56 @@
+package okta
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestChromeDeviceTrustRoundTrip(t *testing.T) {
+	dtc := map[string]interface{}{
+		"browser_version":                     "106.0.5249.61",
+		"builtin_dns_client_enabled":          false,
+		"chrome_remote_desktop_app_blocked":   true,
+		"device_enrollment_domain":            "example.com",
+		"disk_encrypted":                      true,
+		"key_trust_level":                     "CHROME_BROWSER_OS_KEY",
+		"os_firewall":                         false,
+		"os_version":                          "",
+		"password_protection_warning_trigger": "PASSWORD_REUSE",
+		"realtime_url_check_mode":             false,
+		"safe_browsing_protection_level":      "STANDARD_PROTECTION",
+		"screen_lock_secured":                 true,
+		"site_isolation_enabled":              false,
+		"crowd_strike_agent_id":               "",
+		"crowd_strike_customer_id":            "customer",
+		"secure_boot_enabled":                 true,
+		"third_party_blocking_enabled":        false,
+		"windows_machine_domain":              "",
+		"windows_user_domain":                 "example.com",
+	}
+	d := schema.TestResourceDataRaw(t, resourcePolicyDeviceAssuranceWindows().Schema, map[string]interface{}{
+		"name":                "windows",
+		"chrome_device_trust": []interface{}{dtc},
+	})
+	built := buildChromeDeviceTrust(d)
+	if built.OsVersion != nil {
+		t.Errorf("expected an empty os_version not to be sent, got %+v", built.OsVersion)
+	}
+	if built.OsFirewall != nil {
+		t.Errorf("expected a disabled signal not to be sent, got %v", *built.OsFirewall)
+	}
+	if built.BrowserVersion == nil || built.BrowserVersion.Minimum != "106.0.5249.61" {
+		t.Errorf("unexpected browser version %+v", built.BrowserVersion)
+	}
+	flattened := flattenChromeDeviceTrust(built, true)
+	if !reflect.DeepEqual(flattened, []interface{}{dtc}) {
+		t.Errorf("expected %+v, got %+v", []interface{}{dtc}, flattened)
+	}
+	if _, ok := flattenChromeDeviceTrust(built, false)[0].(map[string]interface{})["secure_boot_enabled"]; ok {
+		t.Error("expected the Windows only signals to be omitted")
+	}
+	if flattened := flattenChromeDeviceTrust(nil, false); flattened == nil || len(flattened) != 0 {
+		t.Errorf("expected an empty block without Chrome Device Trust signals, got %+v", flattened)
+	}
+}
@@ -0,0 +1,134 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// hookOAuthSchema is shared by the inline and the event hooks, the channel of
+// a hook with OAuth 2.0 client auth is of type OAUTH.
+var hookOAuthSchema = &schema.Schema{
+	Type:          schema.TypeList,
+	Optional:      true,
+	MaxItems:      1,
+	ConflictsWith: []string{"auth"},
+	Description:   "OAuth 2.0 client authentication used by Okta to call the hook, conflicts with 'auth'",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auth_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.HookOAuthClientSecretPost, sdk.HookOAuthPrivateKeyJwt}),
+				Description:      "Client authentication method: client_secret_post or private_key_jwt",
+			},
+			"token_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsURL("https"),
+				Description:      "Token endpoint of the authorization server",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID registered with the authorization server",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret, required for client_secret_post",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Space separated scopes to request",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the hook key signing the client assertion, required for private_key_jwt",
+			},
+		},
+	},
+}
+
+// validateHookOAuth is run at plan time, each client authentication method
+// requires its own credential.
+func validateHookOAuth(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if _, ok := d.GetOk("oauth"); !ok {
+		if configuredHookChannelType(d) == sdk.HookChannelTypeOAuth {
+			return fmt.Errorf("'oauth' is required when 'channel.type' is '%s'", sdk.HookChannelTypeOAuth)
+		}
+		return nil
+	}
+	if t, ok := d.Get("channel").(map[string]interface{})["type"]; ok && t != sdk.HookChannelTypeOAuth {
+		return fmt.Errorf("'channel.type' must be '%s' when 'oauth' is set", sdk.HookChannelTypeOAuth)
+	}
+	hasSecret := d.Get("oauth.0.client_secret").(string) != "" || !d.NewValueKnown("oauth.0.client_secret")
+	hasKey := d.Get("oauth.0.key_id").(string) != "" || !d.NewValueKnown("oauth.0.key_id")
+	switch d.Get("oauth.0.auth_type").(string) {
+	case sdk.HookOAuthClientSecretPost:
+		if !hasSecret || hasKey {
+			return errors.New("'oauth.client_secret' is required and 'oauth.key_id' can not be set for client_secret_post")
+		}
+	case sdk.HookOAuthPrivateKeyJwt:
+		if !hasKey || hasSecret {
+			return errors.New("'oauth.key_id' is required and 'oauth.client_secret' can not be set for private_key_jwt")
+		}
+	}
+	return nil
+}
+
+// configuredHookChannelType returns the channel type set in the HCL, the type
+// in the state is kept when it is left out.
+func configuredHookChannelType(d *schema.ResourceDiff) string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return ""
+	}
+	channel := raw.GetAttr("channel")
+	if channel.IsNull() || !channel.IsWhollyKnown() {
+		return ""
+	}
+	t, ok := channel.AsValueMap()["type"]
+	if !ok || t.IsNull() {
+		return ""
+	}
+	return t.AsString()
+}
+
+// buildHookOAuth returns nil if the hook doesn't use OAuth 2.0 client auth
+func buildHookOAuth(d *schema.ResourceData) *sdk.HookChannelConfigOAuth {
+	if _, ok := d.GetOk("oauth"); !ok {
+		return nil
+	}
+	return &sdk.HookChannelConfigOAuth{
+		AuthType:     d.Get("oauth.0.auth_type").(string),
+		TokenUrl:     d.Get("oauth.0.token_url").(string),
+		ClientId:     d.Get("oauth.0.client_id").(string),
+		ClientSecret: d.Get("oauth.0.client_secret").(string),
+		Scope:        d.Get("oauth.0.scope").(string),
+		HookKeyId:    d.Get("oauth.0.key_id").(string),
+	}
+}
+
+func flattenHookOAuth(d *schema.ResourceData, channelType string, c sdk.HookChannelConfigOAuth) []interface{} {
+	if channelType != sdk.HookChannelTypeOAuth {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auth_type": c.AuthType,
+			"token_url": c.TokenUrl,
+			"client_id": c.ClientId,
+			// Read only
+			"client_secret": d.Get("oauth.0.client_secret").(string),
+			"scope":         c.Scope,
+			"key_id":        c.HookKeyId,
+		},
+	}
+}
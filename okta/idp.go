@@ -174,6 +174,13 @@ var (
 		Required:         true,
 		ValidateDiagFunc: stringIsURL(validURLSchemes...),
 	}
+
+	signingKidSchema = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "ID of the key Okta signs the requests to the IdP with. Keys created for the IdP have to be switched to with okta_idp_active_signing_key",
+	}
 )
 
 func buildIdpSchema(idpSchema map[string]*schema.Schema) map[string]*schema.Schema {
@@ -308,6 +315,21 @@ func buildProtocolAlgorithmType(d *schema.ResourceData, key string) *okta.Protoc
 	}
 }
 
+// buildIdpSigningCredentials returns nil when the signing key isn't known, the
+// IdP keeps its current key in that case.
+func buildIdpSigningCredentials(d *schema.ResourceData) *okta.IdentityProviderCredentialsSigning {
+	if kid, ok := d.GetOk("signing_kid"); ok {
+		return &okta.IdentityProviderCredentialsSigning{Kid: kid.(string)}
+	}
+	return nil
+}
+
+func syncIdpSigningKid(d *schema.ResourceData, credentials *okta.IdentityProviderCredentials) {
+	if credentials != nil && credentials.Signing != nil {
+		_ = d.Set("signing_kid", credentials.Signing.Kid)
+	}
+}
+
 func buildProtocolEndpoints(d *schema.ResourceData) *okta.ProtocolEndpoints {
 	return &okta.ProtocolEndpoints{
 		Authorization: buildProtocolEndpoint(d, "authorization"),
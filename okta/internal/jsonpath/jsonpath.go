@@ -0,0 +1,204 @@
+// Package jsonpath evaluates a subset of JSONPath expressions against decoded
+// JSON values, i.e. values produced by json.Unmarshal into an interface{}.
+//
+// Supported syntax:
+//
+//	$            the root value
+//	.name        child attribute
+//	['name']     child attribute, for names containing special characters
+//	[n]          array element, negative indexes count from the end
+//	.* or [*]    all children of an object or array
+//	..name       recursive descent, any of the above can follow '..'
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	stepKey stepKind = iota
+	stepIndex
+	stepWildcard
+)
+
+type step struct {
+	kind      stepKind
+	key       string
+	index     int
+	recursive bool
+}
+
+// Get returns all the values of data matching the expression.
+func Get(expr string, data interface{}) ([]interface{}, error) {
+	steps, err := parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	current := []interface{}{data}
+	for _, s := range steps {
+		var next []interface{}
+		for _, node := range current {
+			candidates := []interface{}{node}
+			if s.recursive {
+				candidates = descendants(node)
+			}
+			for _, c := range candidates {
+				next = append(next, s.apply(c)...)
+			}
+		}
+		current = next
+	}
+	return current, nil
+}
+
+func parse(expr string) ([]step, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("invalid JSONPath expression '%s': should start with '$'", expr)
+	}
+	var (
+		steps     []step
+		recursive bool
+	)
+	for i := 1; i < len(expr); {
+		switch expr[i] {
+		case '.':
+			i++
+			if i < len(expr) && expr[i] == '.' {
+				recursive = true
+				i++
+			}
+			if i < len(expr) && expr[i] == '[' {
+				continue
+			}
+			end := i
+			for end < len(expr) && expr[end] != '.' && expr[end] != '[' {
+				end++
+			}
+			name := expr[i:end]
+			if name == "" {
+				return nil, fmt.Errorf("invalid JSONPath expression '%s': empty attribute name at position %d", expr, i)
+			}
+			s := step{kind: stepKey, key: name, recursive: recursive}
+			if name == "*" {
+				s = step{kind: stepWildcard, recursive: recursive}
+			}
+			steps = append(steps, s)
+			recursive = false
+			i = end
+		case '[':
+			end := closingBracket(expr, i)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid JSONPath expression '%s': missing ']' for '[' at position %d", expr, i)
+			}
+			s, err := parseBracket(expr[i+1 : end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSONPath expression '%s': %v", expr, err)
+			}
+			s.recursive = recursive
+			steps = append(steps, s)
+			recursive = false
+			i = end + 1
+		default:
+			return nil, fmt.Errorf("invalid JSONPath expression '%s': unexpected '%c' at position %d", expr, expr[i], i)
+		}
+	}
+	if recursive {
+		return nil, fmt.Errorf("invalid JSONPath expression '%s': '..' should be followed by an attribute", expr)
+	}
+	return steps, nil
+}
+
+// closingBracket returns the position of the ']' matching the '[' at start,
+// ignoring brackets within quotes.
+func closingBracket(expr string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(expr); i++ {
+		switch {
+		case quote != 0 && expr[i] == quote:
+			quote = 0
+		case quote == 0 && (expr[i] == '\'' || expr[i] == '"'):
+			quote = expr[i]
+		case quote == 0 && expr[i] == ']':
+			return i
+		}
+	}
+	return -1
+}
+
+func parseBracket(content string) (step, error) {
+	content = strings.TrimSpace(content)
+	if content == "*" {
+		return step{kind: stepWildcard}, nil
+	}
+	if len(content) >= 2 && (content[0] == '\'' || content[0] == '"') && content[len(content)-1] == content[0] {
+		return step{kind: stepKey, key: content[1 : len(content)-1]}, nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return step{}, fmt.Errorf("unsupported subscript '[%s]'", content)
+	}
+	return step{kind: stepIndex, index: index}, nil
+}
+
+func (s step) apply(node interface{}) []interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		switch s.kind {
+		case stepKey:
+			if child, ok := v[s.key]; ok {
+				return []interface{}{child}
+			}
+		case stepWildcard:
+			children := make([]interface{}, 0, len(v))
+			for _, k := range sortedKeys(v) {
+				children = append(children, v[k])
+			}
+			return children
+		}
+	case []interface{}:
+		switch s.kind {
+		case stepIndex:
+			i := s.index
+			if i < 0 {
+				i += len(v)
+			}
+			if i >= 0 && i < len(v) {
+				return []interface{}{v[i]}
+			}
+		case stepWildcard:
+			return append([]interface{}{}, v...)
+		}
+	}
+	return nil
+}
+
+// descendants returns the node itself followed by all of its nested values.
+func descendants(node interface{}) []interface{} {
+	result := []interface{}{node}
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for _, k := range sortedKeys(v) {
+			result = append(result, descendants(v[k])...)
+		}
+	case []interface{}:
+		for _, child := range v {
+			result = append(result, descendants(child)...)
+		}
+	}
+	return result
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,59 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const testDocument = `{
+  "id": "00o1",
+  "name": "example",
+  "settings": {"enabled": true, "mode": "STRICT"},
+  "features": [
+    {"id": "ftr1", "status": "ENABLED", "stage": {"value": "GA"}},
+    {"id": "ftr2", "status": "DISABLED", "stage": {"value": "BETA"}}
+  ],
+  "special.key": "dotted"
+}`
+
+func TestGet(t *testing.T) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(testDocument), &data); err != nil {
+		t.Fatalf("failed to unmarshal test document: %v", err)
+	}
+	tests := []struct {
+		expr     string
+		expected []interface{}
+	}{
+		{"$.name", []interface{}{"example"}},
+		{"$.settings.enabled", []interface{}{true}},
+		{"$['special.key']", []interface{}{"dotted"}},
+		{"$.features[0].id", []interface{}{"ftr1"}},
+		{"$.features[-1].id", []interface{}{"ftr2"}},
+		{"$.features[*].status", []interface{}{"ENABLED", "DISABLED"}},
+		{"$.features.*.id", []interface{}{"ftr1", "ftr2"}},
+		{"$..value", []interface{}{"GA", "BETA"}},
+		{"$.settings.*", []interface{}{true, "STRICT"}},
+		{"$.missing", nil},
+		{"$.features[5]", nil},
+	}
+	for _, test := range tests {
+		actual, err := Get(test.expr, data)
+		if err != nil {
+			t.Errorf("%q returned an error: %v", test.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("%q expected %v, got %v", test.expr, test.expected, actual)
+		}
+	}
+}
+
+func TestGetInvalidExpression(t *testing.T) {
+	for _, expr := range []string{"name", "$.", "$[abc]", "$.features[0", "$..", "$name"} {
+		if _, err := Get(expr, nil); err == nil {
+			t.Errorf("%q expected an error", expr)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+var orgFeatureComputedSchema = map[string]*schema.Schema{
+	"description": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Description of the feature",
+	},
+	"type": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the feature",
+	},
+	"status": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Status of the feature, `ENABLED` or `DISABLED`",
+	},
+	"stage_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "State of the release stage of the feature, `OPEN` or `CLOSED`",
+	},
+	"stage_value": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Release stage of the feature, `EA` or `BETA`",
+	},
+	"dependencies": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Names of the features that have to be enabled for this feature to be enabled",
+	},
+	"dependents": {
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Names of the features that have to be disabled for this feature to be disabled",
+	},
+}
+
+func findOrgFeatureByName(ctx context.Context, m interface{}, name string) (*okta.Feature, error) {
+	features, _, err := getOktaClientFromMetadata(m).Feature.ListFeatures(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list org features: %v", err)
+	}
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature, nil
+		}
+	}
+	return nil, fmt.Errorf("org feature with name '%s' does not exist", name)
+}
+
+// setOrgFeature sets the feature's attributes together with the names of its
+// dependencies and dependents.
+func setOrgFeature(ctx context.Context, d *schema.ResourceData, m interface{}, feature *okta.Feature) error {
+	dependencies, _, err := getOktaClientFromMetadata(m).Feature.ListFeatureDependencies(ctx, feature.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list org feature dependencies: %v", err)
+	}
+	dependents, _, err := getOktaClientFromMetadata(m).Feature.ListFeatureDependents(ctx, feature.Id)
+	if err != nil {
+		return fmt.Errorf("failed to list org feature dependents: %v", err)
+	}
+	_ = d.Set("name", feature.Name)
+	_ = d.Set("description", feature.Description)
+	_ = d.Set("type", feature.Type)
+	_ = d.Set("status", feature.Status)
+	if feature.Stage != nil {
+		_ = d.Set("stage_state", feature.Stage.State)
+		_ = d.Set("stage_value", feature.Stage.Value)
+	}
+	return setNonPrimitives(d, map[string]interface{}{
+		"dependencies": convertStringSliceToSet(orgFeatureNames(dependencies)),
+		"dependents":   convertStringSliceToSet(orgFeatureNames(dependents)),
+	})
+}
+
+func orgFeatureNames(features []*okta.Feature) []string {
+	names := make([]string, len(features))
+	for i := range features {
+		names[i] = features[i].Name
+	}
+	return names
+}
@@ -174,6 +174,21 @@ func buildPolicyNetworkCondition(d *schema.ResourceData) *okta.PolicyNetworkCond
 	}
 }
 
+// validatePolicyRuleNetwork checks that network zones are only configured
+// alongside the 'ZONE' network connection, which is what the API expects.
+func validatePolicyRuleNetwork(d *schema.ResourceDiff) error {
+	if !newValuesKnown(d, "network_connection", "network_includes", "network_excludes") {
+		return nil
+	}
+	connection := d.Get("network_connection").(string)
+	includes := convertInterfaceToStringArrNullable(d.Get("network_includes"))
+	excludes := convertInterfaceToStringArrNullable(d.Get("network_excludes"))
+	if connection != "ZONE" && (len(includes) > 0 || len(excludes) > 0) {
+		return fmt.Errorf("'network_includes' and 'network_excludes' can only be set when 'network_connection' is 'ZONE', got: '%s'", connection)
+	}
+	return nil
+}
+
 func getPolicyRule(ctx context.Context, d *schema.ResourceData, m interface{}) (*sdk.PolicyRule, error) {
 	client := getSupplementFromMetadata(m)
 	policyID := d.Get("policy_id").(string)
@@ -320,3 +335,14 @@ func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, chec
 	}
 	return nil
 }
+
+// newValuesKnown reports whether the planned values of all the keys are known,
+// plan time checks are skipped for values only known after apply.
+func newValuesKnown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+	return true
+}
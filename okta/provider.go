@@ -20,21 +20,33 @@ const (
 	adminRoleCustom               = "okta_admin_role_custom"
 	adminRoleCustomAssignments    = "okta_admin_role_custom_assignments"
 	adminRoleTargets              = "okta_admin_role_targets"
+	apiData                       = "okta_api_data"
+	apiObject                     = "okta_api_object"
 	app                           = "okta_app"
 	appAutoLogin                  = "okta_app_auto_login"
 	appBasicAuth                  = "okta_app_basic_auth"
 	appBookmark                   = "okta_app_bookmark"
+	appCsr                        = "okta_app_csr"
+	appCsrCertificate             = "okta_app_csr_certificate"
+	appFeatures                   = "okta_app_features"
 	appGroupAssignment            = "okta_app_group_assignment"
 	appGroupAssignments           = "okta_app_group_assignments"
+	appGroupPushMapping           = "okta_app_group_push_mapping"
+	appGroupPushMappings          = "okta_app_group_push_mappings"
+	appKey                        = "okta_app_key"
+	appKeyClone                   = "okta_app_key_clone"
 	appMetadataSaml               = "okta_app_metadata_saml"
 	appOAuth                      = "okta_app_oauth"
 	appOAuthAPIScope              = "okta_app_oauth_api_scope"
 	appOAuthPostLogoutRedirectURI = "okta_app_oauth_post_logout_redirect_uri"
 	appOAuthRedirectURI           = "okta_app_oauth_redirect_uri"
+	appOAuthRoleAssignment        = "okta_app_oauth_role_assignment"
+	appProvisioningConnection     = "okta_app_provisioning_connection"
 	appSaml                       = "okta_app_saml"
 	appSamlAppSettings            = "okta_app_saml_app_settings"
 	appSecurePasswordStore        = "okta_app_secure_password_store"
 	appSharedCredentials          = "okta_app_shared_credentials"
+	appSigningKey                 = "okta_app_signing_key"
 	appSignOnPolicy               = "okta_app_signon_policy"
 	appSignOnPolicyRule           = "okta_app_signon_policy_rule"
 	appSwa                        = "okta_app_swa"
@@ -49,6 +61,8 @@ const (
 	authServerClaimDefault        = "okta_auth_server_claim_default"
 	authServerClaims              = "okta_auth_server_claims"
 	authServerDefault             = "okta_auth_server_default"
+	authServerKeyRotation         = "okta_auth_server_key_rotation"
+	authServerKeys                = "okta_auth_server_keys"
 	authServerPolicy              = "okta_auth_server_policy"
 	authServerPolicyRule          = "okta_auth_server_policy_rule"
 	authServerScope               = "okta_auth_server_scope"
@@ -56,6 +70,9 @@ const (
 	behavior                      = "okta_behavior"
 	behaviors                     = "okta_behaviors"
 	brand                         = "okta_brand"
+	brandErrorPage                = "okta_brand_error_page"
+	brandSignInPage               = "okta_brand_sign_in_page"
+	brandSignOutPage              = "okta_brand_sign_out_page"
 	brands                        = "okta_brands"
 	captcha                       = "okta_captcha"
 	captchaOrgWideSettings        = "okta_captcha_org_wide_settings"
@@ -64,6 +81,8 @@ const (
 	domain                        = "okta_domain"
 	domainCertificate             = "okta_domain_certificate"
 	domainVerification            = "okta_domain_verification"
+	emailDomain                   = "okta_email_domain"
+	emailDomainVerification       = "okta_email_domain_verification"
 	emailSender                   = "okta_email_sender"
 	emailSenderVerification       = "okta_email_sender_verification"
 	emailCustomization            = "okta_email_customization"
@@ -78,11 +97,19 @@ const (
 	groupEveryone                 = "okta_everyone_group"
 	groupMembership               = "okta_group_membership"
 	groupMemberships              = "okta_group_memberships"
+	groupOwner                    = "okta_group_owner"
+	groupOwners                   = "okta_group_owners"
 	groupRole                     = "okta_group_role"
 	groupRoles                    = "okta_group_roles"
 	groupRule                     = "okta_group_rule"
 	groups                        = "okta_groups"
 	groupSchemaProperty           = "okta_group_schema_property"
+	hookKey                       = "okta_hook_key"
+	idpActiveSigningKey           = "okta_idp_active_signing_key"
+	idpCsr                        = "okta_idp_csr"
+	idpCsrCertificate             = "okta_idp_csr_certificate"
+	idpKey                        = "okta_idp_key"
+	idpKeys                       = "okta_idp_keys"
 	idpMetadataSaml               = "okta_idp_metadata_saml"
 	idpOidc                       = "okta_idp_oidc"
 	idpSaml                       = "okta_idp_saml"
@@ -91,10 +118,18 @@ const (
 	inlineHook                    = "okta_inline_hook"
 	linkDefinition                = "okta_link_definition"
 	linkValue                     = "okta_link_value"
+	logStream                     = "okta_log_stream"
 	networkZone                   = "okta_network_zone"
 	orgConfiguration              = "okta_org_configuration"
+	orgFeature                    = "okta_org_feature"
+	orgMetadata                   = "okta_org_metadata"
 	orgSupport                    = "okta_org_support"
 	policy                        = "okta_policy"
+	policyDeviceAssuranceAndroid  = "okta_policy_device_assurance_android"
+	policyDeviceAssuranceChromeOS = "okta_policy_device_assurance_chromeos"
+	policyDeviceAssuranceIOS      = "okta_policy_device_assurance_ios"
+	policyDeviceAssuranceMacOS    = "okta_policy_device_assurance_macos"
+	policyDeviceAssuranceWindows  = "okta_policy_device_assurance_windows"
 	policyMfa                     = "okta_policy_mfa"
 	policyMfaDefault              = "okta_policy_mfa_default"
 	policyPassword                = "okta_policy_password"
@@ -107,11 +142,17 @@ const (
 	policyRuleProfileEnrollment   = "okta_policy_rule_profile_enrollment"
 	policyRuleSignOn              = "okta_policy_rule_signon"
 	policySignOn                  = "okta_policy_signon"
+	principalRateLimit            = "okta_principal_rate_limit"
 	profileMapping                = "okta_profile_mapping"
+	pushProviderAPNS              = "okta_push_provider_apns"
+	pushProviderFCM               = "okta_push_provider_fcm"
 	rateLimiting                  = "okta_rate_limiting"
+	realm                         = "okta_realm"
+	realmAssignment               = "okta_realm_assignment"
 	resourceSet                   = "okta_resource_set"
 	roleSubscription              = "okta_role_subscription"
 	securityNotificationEmails    = "okta_security_notification_emails"
+	systemLog                     = "okta_system_log"
 	templateEmail                 = "okta_template_email"
 	templateSms                   = "okta_template_sms"
 	theme                         = "okta_theme"
@@ -122,7 +163,9 @@ const (
 	user                          = "okta_user"
 	userAdminRoles                = "okta_user_admin_roles"
 	userBaseSchemaProperty        = "okta_user_base_schema_property"
+	userFactor                    = "okta_user_factor"
 	userFactorQuestion            = "okta_user_factor_question"
+	userFactors                   = "okta_user_factors"
 	userGroupMemberships          = "okta_user_group_memberships"
 	userProfileMappingSource      = "okta_user_profile_mapping_source"
 	users                         = "okta_users"
@@ -258,19 +301,29 @@ func Provider() *schema.Provider {
 			adminRoleCustom:               resourceAdminRoleCustom(),
 			adminRoleCustomAssignments:    resourceAdminRoleCustomAssignments(),
 			adminRoleTargets:              resourceAdminRoleTargets(),
+			apiObject:                     resourceAPIObject(),
 			appAutoLogin:                  resourceAppAutoLogin(),
 			appBasicAuth:                  resourceAppBasicAuth(),
 			appBookmark:                   resourceAppBookmark(),
+			appCsr:                        resourceAppCsr(),
+			appCsrCertificate:             resourceAppCsrCertificate(),
+			appFeatures:                   resourceAppFeatures(),
 			appGroupAssignment:            resourceAppGroupAssignment(),
 			appGroupAssignments:           resourceAppGroupAssignments(),
+			appGroupPushMapping:           resourceAppGroupPushMapping(),
+			appKey:                        resourceAppKey(),
+			appKeyClone:                   resourceAppKeyClone(),
 			appOAuth:                      resourceAppOAuth(),
 			appOAuthAPIScope:              resourceAppOAuthAPIScope(),
 			appOAuthPostLogoutRedirectURI: resourceAppOAuthPostLogoutRedirectURI(),
 			appOAuthRedirectURI:           resourceAppOAuthRedirectURI(),
+			appOAuthRoleAssignment:        resourceAppOAuthRoleAssignment(),
+			appProvisioningConnection:     resourceAppProvisioningConnection(),
 			appSaml:                       resourceAppSaml(),
 			appSamlAppSettings:            resourceAppSamlAppSettings(),
 			appSecurePasswordStore:        resourceAppSecurePasswordStore(),
 			appSharedCredentials:          resourceAppSharedCredentials(),
+			appSigningKey:                 resourceAppSigningKey(),
 			appSignOnPolicy:               resourceAppSignOnPolicy(),
 			appSignOnPolicyRule:           resourceAppSignOnPolicyRule(),
 			appSwa:                        resourceAppSwa(),
@@ -283,17 +336,23 @@ func Provider() *schema.Provider {
 			authServerClaim:               resourceAuthServerClaim(),
 			authServerClaimDefault:        resourceAuthServerClaimDefault(),
 			authServerDefault:             resourceAuthServerDefault(),
+			authServerKeyRotation:         resourceAuthServerKeyRotation(),
 			authServerPolicy:              resourceAuthServerPolicy(),
 			authServerPolicyRule:          resourceAuthServerPolicyRule(),
 			authServerScope:               resourceAuthServerScope(),
 			behavior:                      resourceBehavior(),
 			brand:                         resourceBrand(),
+			brandErrorPage:                resourceBrandErrorPage(),
+			brandSignInPage:               resourceBrandSignInPage(),
+			brandSignOutPage:              resourceBrandSignOutPage(),
 			captcha:                       resourceCaptcha(),
 			captchaOrgWideSettings:        resourceCaptchaOrgWideSettings(),
 			domain:                        resourceDomain(),
 			domainCertificate:             resourceDomainCertificate(),
 			domainVerification:            resourceDomainVerification(),
 			emailCustomization:            resourceEmailCustomization(),
+			emailDomain:                   resourceEmailDomain(),
+			emailDomainVerification:       resourceEmailDomainVerification(),
 			emailSender:                   resourceEmailSender(),
 			emailSenderVerification:       resourceEmailSenderVerification(),
 			eventHook:                     resourceEventHook(),
@@ -303,10 +362,17 @@ func Provider() *schema.Provider {
 			group:                         resourceGroup(),
 			groupMembership:               resourceGroupMembership(),
 			groupMemberships:              resourceGroupMemberships(),
+			groupOwner:                    resourceGroupOwner(),
+			groupOwners:                   resourceGroupOwners(),
 			groupRole:                     resourceGroupRole(),
 			groupRoles:                    resourceGroupRoles(),
 			groupRule:                     resourceGroupRule(),
 			groupSchemaProperty:           resourceGroupCustomSchemaProperty(),
+			hookKey:                       resourceHookKey(),
+			idpActiveSigningKey:           resourceIdpActiveSigningKey(),
+			idpCsr:                        resourceIdpCsr(),
+			idpCsrCertificate:             resourceIdpCsrCertificate(),
+			idpKey:                        resourceIdpKey(),
 			idpOidc:                       resourceIdpOidc(),
 			idpSaml:                       resourceIdpSaml(),
 			idpSamlKey:                    resourceIdpSigningKey(),
@@ -314,9 +380,16 @@ func Provider() *schema.Provider {
 			inlineHook:                    resourceInlineHook(),
 			linkDefinition:                resourceLinkDefinition(),
 			linkValue:                     resourceLinkValue(),
+			logStream:                     resourceLogStream(),
 			networkZone:                   resourceNetworkZone(),
 			orgConfiguration:              resourceOrgConfiguration(),
+			orgFeature:                    resourceOrgFeature(),
 			orgSupport:                    resourceOrgSupport(),
+			policyDeviceAssuranceAndroid:  resourcePolicyDeviceAssuranceAndroid(),
+			policyDeviceAssuranceChromeOS: resourcePolicyDeviceAssuranceChromeOS(),
+			policyDeviceAssuranceIOS:      resourcePolicyDeviceAssuranceIOS(),
+			policyDeviceAssuranceMacOS:    resourcePolicyDeviceAssuranceMacOS(),
+			policyDeviceAssuranceWindows:  resourcePolicyDeviceAssuranceWindows(),
 			policyMfa:                     resourcePolicyMfa(),
 			policyMfaDefault:              resourcePolicyMfaDefault(),
 			policyPassword:                resourcePolicyPassword(),
@@ -329,8 +402,13 @@ func Provider() *schema.Provider {
 			policyRuleProfileEnrollment:   resourcePolicyProfileEnrollmentRule(),
 			policyRuleSignOn:              resourcePolicySignOnRule(),
 			policySignOn:                  resourcePolicySignOn(),
+			principalRateLimit:            resourcePrincipalRateLimit(),
 			profileMapping:                resourceProfileMapping(),
+			pushProviderAPNS:              resourcePushProviderAPNS(),
+			pushProviderFCM:               resourcePushProviderFCM(),
 			rateLimiting:                  resourceRateLimiting(),
+			realm:                         resourceRealm(),
+			realmAssignment:               resourceRealmAssignment(),
 			resourceSet:                   resourceResourceSet(),
 			roleSubscription:              resourceRoleSubscription(),
 			securityNotificationEmails:    resourceSecurityNotificationEmails(),
@@ -342,6 +420,7 @@ func Provider() *schema.Provider {
 			user:                          resourceUser(),
 			userAdminRoles:                resourceUserAdminRoles(),
 			userBaseSchemaProperty:        resourceUserBaseSchemaProperty(),
+			userFactor:                    resourceUserFactor(),
 			userFactorQuestion:            resourceUserFactorQuestion(),
 			userGroupMemberships:          resourceUserGroupMemberships(),
 			userSchemaProperty:            resourceUserCustomSchemaProperty(),
@@ -372,8 +451,11 @@ func Provider() *schema.Provider {
 			"okta_user_base_schema":          deprecateIncorrectNaming(resourceUserBaseSchemaProperty(), userBaseSchemaProperty),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			apiData:                  dataSourceAPIData(),
 			app:                      dataSourceApp(),
+			appFeatures:              dataSourceAppFeatures(),
 			appGroupAssignments:      dataSourceAppGroupAssignments(),
+			appGroupPushMappings:     dataSourceAppGroupPushMappings(),
 			appMetadataSaml:          dataSourceAppMetadataSaml(),
 			appOAuth:                 dataSourceAppOauth(),
 			appSaml:                  dataSourceAppSaml(),
@@ -383,6 +465,7 @@ func Provider() *schema.Provider {
 			authServer:               dataSourceAuthServer(),
 			authServerClaim:          dataSourceAuthServerClaim(),
 			authServerClaims:         dataSourceAuthServerClaims(),
+			authServerKeys:           dataSourceAuthServerKeys(),
 			authServerPolicy:         dataSourceAuthServerPolicy(),
 			authServerScopes:         dataSourceAuthServerScopes(),
 			behavior:                 dataSourceBehavior(),
@@ -398,18 +481,27 @@ func Provider() *schema.Provider {
 			defaultPolicy:            dataSourceDefaultPolicy(),
 			group:                    dataSourceGroup(),
 			groupEveryone:            dataSourceEveryoneGroup(),
+			groupOwners:              dataSourceGroupOwners(),
 			groups:                   dataSourceGroups(),
+			idpKeys:                  dataSourceIdpKeys(),
 			idpMetadataSaml:          dataSourceIdpMetadataSaml(),
 			idpOidc:                  dataSourceIdpOidc(),
 			idpSaml:                  dataSourceIdpSaml(),
 			idpSocial:                dataSourceIdpSocial(),
+			logStream:                dataSourceLogStream(),
 			networkZone:              dataSourceNetworkZone(),
+			orgConfiguration:         dataSourceOrgConfiguration(),
+			orgFeature:               dataSourceOrgFeature(),
+			orgMetadata:              dataSourceOrgMetadata(),
 			policy:                   dataSourcePolicy(),
+			realm:                    dataSourceRealm(),
 			roleSubscription:         dataSourceRoleSubscription(),
+			systemLog:                dataSourceSystemLog(),
 			theme:                    dataSourceTheme(),
 			themes:                   dataSourceThemes(),
 			trustedOrigins:           dataSourceTrustedOrigins(),
 			user:                     dataSourceUser(),
+			userFactors:              dataSourceUserFactors(),
 			userProfileMappingSource: dataSourceUserProfileMappingSource(),
 			users:                    dataSourceUsers(),
 			userSecurityQuestions:    dataSourceUserSecurityQuestions(),
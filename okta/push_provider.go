@@ -0,0 +1,30 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// getPushProvider returns nil if the push provider doesn't exist or is of
+// another provider type.
+func getPushProvider(ctx context.Context, d *schema.ResourceData, m interface{}, providerType string) (*sdk.PushProvider, error) {
+	provider, resp, err := getSupplementFromMetadata(m).GetPushProvider(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, fmt.Errorf("failed to get push provider: %v", err)
+	}
+	if provider == nil || provider.ProviderType != providerType {
+		return nil, nil
+	}
+	return provider, nil
+}
+
+func deletePushProvider(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	resp, err := getSupplementFromMetadata(m).DeletePushProvider(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return fmt.Errorf("failed to delete push provider: %v", err)
+	}
+	return nil
+}
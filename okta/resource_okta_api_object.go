@@ -0,0 +1,290 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// apiObjectIDPlaceholder is replaced with the ID of the object in the read,
+// update and delete paths.
+const apiObjectIDPlaceholder = "{id}"
+
+func resourceAPIObject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAPIObjectCreate,
+		ReadContext:   resourceAPIObjectRead,
+		UpdateContext: resourceAPIObjectUpdate,
+		DeleteContext: resourceAPIObjectDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAPIObjectImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"create_path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsAPIPath,
+				Description:      "API path the object is created with, e.g. '/api/v1/zones'",
+			},
+			"create_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          http.MethodPost,
+				ValidateDiagFunc: elemInSlice([]string{http.MethodPost, http.MethodPut}),
+				Description:      "HTTP method used to create the object: POST or PUT",
+			},
+			"read_path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsAPIPath,
+				Description:      "API path the object is read from. '{id}' is replaced with the ID of the object. Defaults to '<create_path>/{id}'",
+			},
+			"update_path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsAPIPath,
+				Description:      "API path the object is updated with. '{id}' is replaced with the ID of the object. Defaults to the read path",
+			},
+			"update_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          http.MethodPut,
+				ValidateDiagFunc: elemInSlice([]string{http.MethodPut, http.MethodPost, http.MethodPatch}),
+				Description:      "HTTP method used to update the object: PUT, POST or PATCH",
+			},
+			"delete_path": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsAPIPath,
+				Description:      "API path the object is deleted with. '{id}' is replaced with the ID of the object. Defaults to the read path",
+			},
+			"skip_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only remove the object from the state on destroy, for objects that can not be deleted",
+			},
+			"id_attribute": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "id",
+				Description: "Attribute of the API response that contains the ID of the object",
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsJSON,
+				StateFunc:        normalizeDataJSON,
+				Description:      "JSON body of the object sent on create and update",
+			},
+			"compare_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Attributes of the object, in dot notation, that are checked for drift. Defaults to the top level attributes of 'data'",
+			},
+			"api_response": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON response of the last read of the object",
+			},
+		},
+	}
+}
+
+func resourceAPIObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body, err := buildAPIObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obj, _, err := getSupplementFromMetadata(m).CreateAPIObject(ctx, d.Get("create_method").(string), d.Get("create_path").(string), body)
+	if err != nil {
+		return diag.Errorf("failed to create API object: %v", err)
+	}
+	id := apiObjectID(obj, d.Get("id_attribute").(string))
+	switch {
+	case id != "":
+		d.SetId(id)
+	case d.Get("read_path").(string) != "" && !strings.Contains(d.Get("read_path").(string), apiObjectIDPlaceholder):
+		// singleton objects, e.g. org settings, don't have an ID
+		d.SetId(d.Get("read_path").(string))
+	default:
+		return diag.Errorf("failed to create API object: response does not contain '%s' attribute, set 'read_path' without '%s' for objects without an ID",
+			d.Get("id_attribute").(string), apiObjectIDPlaceholder)
+	}
+	return resourceAPIObjectRead(ctx, d, m)
+}
+
+func resourceAPIObjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	obj, resp, err := getSupplementFromMetadata(m).GetAPIObject(ctx, apiObjectPath(d, "read_path"))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get API object: %v", err)
+	}
+	if obj == nil {
+		d.SetId("")
+		return nil
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return diag.Errorf("failed to marshal API object response: %v", err)
+	}
+	_ = d.Set("api_response", string(raw))
+	data, err := flattenAPIObjectData(d, obj)
+	if err != nil {
+		return diag.Errorf("failed to set API object data: %v", err)
+	}
+	_ = d.Set("data", data)
+	return nil
+}
+
+func resourceAPIObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// changing only the paths or methods doesn't require an API call
+	if !d.HasChange("data") {
+		return resourceAPIObjectRead(ctx, d, m)
+	}
+	body, err := buildAPIObject(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateAPIObject(ctx, d.Get("update_method").(string), apiObjectPath(d, "update_path"), body)
+	if err != nil {
+		return diag.Errorf("failed to update API object: %v", err)
+	}
+	return resourceAPIObjectRead(ctx, d, m)
+}
+
+func resourceAPIObjectDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.Get("skip_delete").(bool) {
+		return nil
+	}
+	resp, err := getSupplementFromMetadata(m).DeleteAPIObject(ctx, apiObjectPath(d, "delete_path"))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete API object: %v", err)
+	}
+	return nil
+}
+
+// resourceAPIObjectImport imports an object by its API path, e.g. '/api/v1/zones/nzo1234'.
+// The ID is set the same way as on create: the 'id' attribute of the object,
+// or the path itself for singleton objects without an ID.
+func resourceAPIObjectImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	path := strings.TrimSuffix(d.Id(), "/")
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("invalid API object specifier, expecting an API path like '/api/v1/zones/{id}', got: '%s'", d.Id())
+	}
+	obj, _, err := getSupplementFromMetadata(m).GetAPIObject(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API object: %v", err)
+	}
+	_ = d.Set("read_path", path)
+	id := apiObjectID(obj, "id")
+	if id == "" {
+		id = path
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// apiObjectID returns the ID of the object, or an empty string if the object
+// doesn't have the ID attribute.
+func apiObjectID(obj sdk.APIObject, idAttribute string) string {
+	id, ok := obj[idAttribute]
+	if !ok || id == nil {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
+
+func buildAPIObject(d *schema.ResourceData) (sdk.APIObject, error) {
+	var obj sdk.APIObject
+	err := json.Unmarshal([]byte(d.Get("data").(string)), &obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal 'data': %v", err)
+	}
+	return obj, nil
+}
+
+// apiObjectPath returns the path for the given path attribute with the
+// object's ID filled in, falling back to the read path and then to the
+// create path followed by the ID.
+func apiObjectPath(d *schema.ResourceData, key string) string {
+	path := d.Get(key).(string)
+	if path == "" && key != "read_path" {
+		path = d.Get("read_path").(string)
+	}
+	if path == "" {
+		path = strings.TrimSuffix(d.Get("create_path").(string), "/") + "/" + apiObjectIDPlaceholder
+	}
+	return strings.ReplaceAll(path, apiObjectIDPlaceholder, d.Id())
+}
+
+// flattenAPIObjectData overlays the compared fields of the API response on
+// the configured 'data', so that only drift in those fields produces a diff.
+// Compared fields missing from the response are left as configured.
+// When 'data' is empty, e.g. after an import, the whole response is used.
+func flattenAPIObjectData(d *schema.ResourceData, obj sdk.APIObject) (string, error) {
+	data := map[string]interface{}{}
+	if raw := d.Get("data").(string); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &data); err != nil {
+			return "", err
+		}
+	}
+	if len(data) == 0 {
+		b, err := json.Marshal(obj)
+		return string(b), err
+	}
+	fields := convertInterfaceToStringSetNullable(d.Get("compare_fields"))
+	if len(fields) == 0 {
+		for k := range data {
+			fields = append(fields, k)
+		}
+	}
+	for _, field := range fields {
+		// fields the API doesn't return, e.g. secrets, keep their configured value
+		v, ok := apiObjectValue(obj, field)
+		if !ok {
+			continue
+		}
+		setAPIObjectValue(data, field, v)
+	}
+	b, err := json.Marshal(data)
+	return string(b), err
+}
+
+// apiObjectValue returns the value at the given dot notation path of the object.
+func apiObjectValue(obj map[string]interface{}, path string) (interface{}, bool) {
+	var current interface{} = obj
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = m[key]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// setAPIObjectValue sets the value at the given dot notation path of the
+// object, creating nested objects as needed.
+func setAPIObjectValue(obj map[string]interface{}, path string, v interface{}) {
+	keys := strings.Split(path, ".")
+	current := obj
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := current[key].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			current[key] = next
+		}
+		current = next
+	}
+	current[keys[len(keys)-1]] = v
+}
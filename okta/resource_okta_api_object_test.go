@@ -0,0 +1,113 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaAPIObject(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(apiObject)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", apiObject)
+	resource.Test(
+		t, resource.TestCase{
+			PreCheck:          testAccPreCheck(t),
+			ErrorCheck:        testAccErrorChecks(t),
+			ProviderFactories: testAccProvidersFactories,
+			CheckDestroy:      createCheckResourceDestroy(apiObject, doesAPIObjectExist),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(resourceName, "id"),
+						resource.TestCheckResourceAttrSet(resourceName, "api_response"),
+						resource.TestCheckResourceAttr(resourceName, "create_method", "POST"),
+						resource.TestCheckResourceAttr(resourceName, "compare_fields.#", "3"),
+					),
+				},
+				{
+					Config: updated,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet(resourceName, "id"),
+						resource.TestCheckResourceAttr(resourceName, "update_path", "/api/v1/trustedOrigins/{id}"),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"create_path", "update_path", "read_path", "compare_fields", "data"},
+					ImportStateIdFunc: func(s *terraform.State) (string, error) {
+						rs, ok := s.RootModule().Resources[resourceName]
+						if !ok {
+							return "", fmt.Errorf("failed to find %s", resourceName)
+						}
+						return fmt.Sprintf("/api/v1/trustedOrigins/%s", rs.Primary.ID), nil
+					},
+				},
+			},
+		})
+}
+
+func TestAPIObjectValue(t *testing.T) {
+	obj := map[string]interface{}{
+		"name": "example",
+		"settings": map[string]interface{}{
+			"enabled": true,
+		},
+	}
+	v, ok := apiObjectValue(obj, "settings.enabled")
+	if !ok || v != true {
+		t.Errorf("expected 'settings.enabled' to be true, got: %v", v)
+	}
+	if _, ok := apiObjectValue(obj, "name.first"); ok {
+		t.Error("expected 'name.first' to not be found")
+	}
+	setAPIObjectValue(obj, "settings.mode", "STRICT")
+	setAPIObjectValue(obj, "profile.type", "CUSTOM")
+	if v, _ := apiObjectValue(obj, "settings.mode"); v != "STRICT" {
+		t.Errorf("expected 'settings.mode' to be 'STRICT', got: %v", v)
+	}
+	if v, _ := apiObjectValue(obj, "profile.type"); v != "CUSTOM" {
+		t.Errorf("expected 'profile.type' to be 'CUSTOM', got: %v", v)
+	}
+}
+
+func TestAPIObjectID(t *testing.T) {
+	if id := apiObjectID(sdk.APIObject{"id": "tos1234"}, "id"); id != "tos1234" {
+		t.Errorf("expected ID to be 'tos1234', got: '%s'", id)
+	}
+	if id := apiObjectID(sdk.APIObject{"support": "ENABLED", "id": nil}, "id"); id != "" {
+		t.Errorf("expected no ID for a singleton object, got: '%s'", id)
+	}
+}
+
+func TestFlattenAPIObjectData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAPIObject().Schema, map[string]interface{}{
+		"create_path": "/api/v1/idps",
+		"data":        `{"name":"example","client_secret":"secret"}`,
+	})
+	obj := sdk.APIObject{"id": "0oa1234", "name": "changed"}
+	data, err := flattenAPIObjectData(d, obj)
+	if err != nil {
+		t.Fatalf("failed to flatten API object data: %v", err)
+	}
+	expected := `{"client_secret":"secret","name":"changed"}`
+	if data != expected {
+		t.Errorf("expected data to be '%s', got: '%s'", expected, data)
+	}
+}
+
+func doesAPIObjectExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetAPIObject(context.Background(), fmt.Sprintf("/api/v1/trustedOrigins/%s", id))
+	return doesResourceExist(response, err)
+}
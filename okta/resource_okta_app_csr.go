@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAppCsr() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppCsrCreate,
+		ReadContext:   resourceAppCsrRead,
+		DeleteContext: resourceAppCsrDelete,
+		Importer:      createNestedResourceImporter([]string{"app_id", "id"}),
+		Schema: buildSchema(csrSchema, map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app",
+			},
+		}),
+	}
+}
+
+func resourceAppCsrCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csr, _, err := getOktaClientFromMetadata(m).Application.GenerateCsrForApplication(ctx, d.Get("app_id").(string), buildCsrMetadata(d))
+	if err != nil {
+		return diag.Errorf("failed to generate app CSR: %v", err)
+	}
+	d.SetId(csr.Id)
+	return resourceAppCsrRead(ctx, d, m)
+}
+
+func resourceAppCsrRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	appID := d.Get("app_id").(string)
+	csr, resp, err := client.Application.GetCsrForApplication(ctx, appID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app CSR: %v", err)
+	}
+	if csr == nil {
+		// Publishing a certificate for the CSR removes the CSR and adds a key
+		// with the same ID, the CSR is kept in the state in that case.
+		key, resp, err := client.Application.GetApplicationKey(ctx, appID, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to get app key: %v", err)
+		}
+		if key == nil {
+			d.SetId("")
+		}
+		return nil
+	}
+	if err := setCsr(d, csr); err != nil {
+		return diag.Errorf("failed to set app CSR properties: %v", err)
+	}
+	return nil
+}
+
+func resourceAppCsrDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getOktaClientFromMetadata(m).Application.RevokeCsrFromApplication(ctx, d.Get("app_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to revoke app CSR: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Publishing the certificate turns the CSR into a key of the app. Keys of an
+// app can't be deleted, destroying the resource only removes it from the
+// state.
+func resourceAppCsrCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppCsrCertificateCreate,
+		ReadContext:   resourceAppCsrCertificateRead,
+		DeleteContext: resourceFuncNoOp,
+		Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app",
+			},
+			"csr_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CSR the certificate was signed for",
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringIsPEMCertificate,
+				Description:      "PEM encoded certificate signed by the CA for the CSR",
+			},
+		}),
+	}
+}
+
+func resourceAppCsrCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, _, err := getSupplementFromMetadata(m).PublishAppCsr(ctx, d.Get("app_id").(string), d.Get("csr_id").(string),
+		[]byte(d.Get("certificate").(string)))
+	if err != nil {
+		return diag.Errorf("failed to publish app CSR certificate: %v", err)
+	}
+	d.SetId(key.Kid)
+	return resourceAppCsrCertificateRead(ctx, d, m)
+}
+
+func resourceAppCsrCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getOktaClientFromMetadata(m).Application.GetApplicationKey(ctx, d.Get("app_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := setJSONWebKey(d, key); err != nil {
+		return diag.Errorf("failed to set app key properties: %v", err)
+	}
+	return nil
+}
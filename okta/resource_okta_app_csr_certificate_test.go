@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringIsPEMCertificate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "SP Issuer"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	invalidPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}))
+
+	tests := []struct {
+		name  string
+		value string
+		valid bool
+	}{
+		{"certificate", certPEM, true},
+		{"not PEM", "MIIC", false},
+		{"private key", keyPEM, false},
+		{"invalid certificate", invalidPEM, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := stringIsPEMCertificate(test.value, cty.GetAttrPath("certificate"))
+			assert.Equal(t, test.valid, !diags.HasError())
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package okta
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAppCsr_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appCsr)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appCsr)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttrSet(resourceName, "csr"),
+					resource.TestMatchResourceAttr(resourceName, "csr_pem", regexp.MustCompile(`^-----BEGIN CERTIFICATE REQUEST-----`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       appKeyImportStateID(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subject", "dns_names"},
+			},
+		},
+	})
+}
+
+func TestCsrToPEM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "SP Issuer"},
+		DNSNames: []string{"dev.okta.com"},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, err := csrToPEM(base64.StdEncoding.EncodeToString(der))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil {
+		t.Fatal("expected a PEM block")
+	}
+	assert.Equal(t, "CERTIFICATE REQUEST", block.Type)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "SP Issuer", csr.Subject.CommonName)
+	assert.Equal(t, []string{"dev.okta.com"}, csr.DNSNames)
+
+	_, err = csrToPEM("not base64")
+	assert.Error(t, err)
+}
@@ -0,0 +1,388 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppFeatures() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppFeaturesCreate,
+		ReadContext:   resourceAppFeaturesRead,
+		UpdateContext: resourceAppFeaturesUpdate,
+		DeleteContext: resourceAppFeaturesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppFeatures,
+		},
+		Description: "Manages the provisioning features of an application. The features are only available once provisioning is enabled for the application.",
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application",
+			},
+			"user_provisioning": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"user_provisioning", "inbound_provisioning"},
+				Description:  "Settings of the USER_PROVISIONING feature, provisioning from Okta to the application",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the feature",
+						},
+						"create_users": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Create users in the application when they are assigned to it",
+						},
+						"update_user_attributes": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Push changes of the Okta user profile to the application",
+						},
+						"deactivate_users": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Deactivate users in the application when they are unassigned from it or deactivated in Okta",
+						},
+						"password_sync": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Sync user passwords to the application",
+						},
+						"password_seed": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "RANDOM",
+							ValidateDiagFunc: elemInSlice([]string{"OKTA", "RANDOM"}),
+							Description:      "Whether the Okta password or a random password is synced. Valid values: `OKTA`, `RANDOM`",
+						},
+						"password_change": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "KEEP_EXISTING",
+							ValidateDiagFunc: elemInSlice([]string{"CHANGE", "KEEP_EXISTING"}),
+							Description:      "Whether the random password is changed or the existing one is kept when the password is synced. Valid values: `CHANGE`, `KEEP_EXISTING`",
+						},
+					},
+				},
+			},
+			"inbound_provisioning": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"user_provisioning", "inbound_provisioning"},
+				Description:  "Settings of the INBOUND_PROVISIONING feature, importing users from the application to Okta",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the feature",
+						},
+						"username_format": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Format of the username of imported users, `CUSTOM` to use `username_expression`",
+						},
+						"username_expression": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Okta Expression Language expression of the username of imported users",
+						},
+						"schedule_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether users are imported on a schedule",
+						},
+						"full_import_expression": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Cron expression of the full import schedule",
+						},
+						"full_import_timezone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Timezone of the full import schedule",
+						},
+						"incremental_import_expression": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Cron expression of the incremental import schedule",
+						},
+						"incremental_import_timezone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Timezone of the incremental import schedule",
+						},
+						"exact_match_criteria": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "USERNAME",
+							ValidateDiagFunc: elemInSlice([]string{"USERNAME", "EMAIL"}),
+							Description:      "Attribute imported users are matched to existing Okta users with. Valid values: `USERNAME`, `EMAIL`",
+						},
+						"allow_partial_match": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Allow matching imported users on first and last name",
+						},
+						"auto_confirm_partial_match": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Confirm partial matches automatically",
+						},
+						"auto_confirm_exact_match": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Confirm exact matches automatically",
+						},
+						"auto_confirm_new_users": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Confirm new users automatically",
+						},
+						"auto_activate_new_users": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Activate new users automatically",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func importAppFeatures(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("app_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAppFeaturesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateAppFeatures(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("app_id").(string))
+	return resourceAppFeaturesRead(ctx, d, m)
+}
+
+func resourceAppFeaturesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	features, resp, err := getSupplementFromMetadata(m).ListAppFeatures(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to list application features: %v", err)
+	}
+	if features == nil {
+		d.SetId("")
+		return nil
+	}
+	rawMap := map[string]interface{}{
+		"user_provisioning":    nil,
+		"inbound_provisioning": nil,
+	}
+	for _, feature := range features {
+		switch feature.Name {
+		case sdk.AppFeatureUserProvisioning:
+			rawMap["user_provisioning"] = flattenUserProvisioningFeature(feature)
+		case sdk.AppFeatureInboundProvisioning:
+			rawMap["inbound_provisioning"] = flattenInboundProvisioningFeature(feature)
+		}
+	}
+	err = setNonPrimitives(d, rawMap)
+	if err != nil {
+		return diag.Errorf("failed to set application features: %v", err)
+	}
+	return nil
+}
+
+func resourceAppFeaturesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := updateAppFeatures(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceAppFeaturesRead(ctx, d, m)
+}
+
+// resourceAppFeaturesDelete only removes the resource from the state, the
+// features can't be removed from an application.
+func resourceAppFeaturesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func updateAppFeatures(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	appID := d.Get("app_id").(string)
+	if _, ok := d.GetOk("user_provisioning.0"); ok && d.HasChange("user_provisioning") {
+		_, _, err := getSupplementFromMetadata(m).UpdateAppFeature(ctx, appID, sdk.AppFeatureUserProvisioning, buildUserProvisioningFeature(d))
+		if err != nil {
+			return fmt.Errorf("failed to update %s application feature: %v", sdk.AppFeatureUserProvisioning, err)
+		}
+	}
+	if _, ok := d.GetOk("inbound_provisioning.0"); ok && d.HasChange("inbound_provisioning") {
+		_, _, err := getSupplementFromMetadata(m).UpdateAppFeature(ctx, appID, sdk.AppFeatureInboundProvisioning, buildInboundProvisioningFeature(d))
+		if err != nil {
+			return fmt.Errorf("failed to update %s application feature: %v", sdk.AppFeatureInboundProvisioning, err)
+		}
+	}
+	return nil
+}
+
+func buildUserProvisioningFeature(d *schema.ResourceData) sdk.ApplicationFeatureCapabilities {
+	return sdk.ApplicationFeatureCapabilities{
+		CapabilitiesObject: okta.CapabilitiesObject{
+			Create: &okta.CapabilitiesCreateObject{
+				LifecycleCreate: &okta.LifecycleCreateSettingObject{
+					Status: featureStatus(d.Get("user_provisioning.0.create_users").(bool)),
+				},
+			},
+			Update: &okta.CapabilitiesUpdateObject{
+				LifecycleDeactivate: &okta.LifecycleDeactivateSettingObject{
+					Status: featureStatus(d.Get("user_provisioning.0.deactivate_users").(bool)),
+				},
+				Password: &okta.PasswordSettingObject{
+					Change: d.Get("user_provisioning.0.password_change").(string),
+					Seed:   d.Get("user_provisioning.0.password_seed").(string),
+					Status: featureStatus(d.Get("user_provisioning.0.password_sync").(bool)),
+				},
+				Profile: &okta.ProfileSettingObject{
+					Status: featureStatus(d.Get("user_provisioning.0.update_user_attributes").(bool)),
+				},
+			},
+		},
+	}
+}
+
+func buildInboundProvisioningFeature(d *schema.ResourceData) sdk.ApplicationFeatureCapabilities {
+	schedule := &sdk.ImportSchedule{
+		Status: featureStatus(d.Get("inbound_provisioning.0.schedule_enabled").(bool)),
+	}
+	if expr := d.Get("inbound_provisioning.0.full_import_expression").(string); expr != "" {
+		schedule.FullImport = &sdk.ImportScheduleObject{
+			Expression: expr,
+			Timezone:   d.Get("inbound_provisioning.0.full_import_timezone").(string),
+		}
+	}
+	if expr := d.Get("inbound_provisioning.0.incremental_import_expression").(string); expr != "" {
+		schedule.IncrementalImport = &sdk.ImportScheduleObject{
+			Expression: expr,
+			Timezone:   d.Get("inbound_provisioning.0.incremental_import_timezone").(string),
+		}
+	}
+	return sdk.ApplicationFeatureCapabilities{
+		ImportSettings: &sdk.ImportSettings{
+			Username: &sdk.ImportUsername{
+				UsernameFormat:     d.Get("inbound_provisioning.0.username_format").(string),
+				UserNameExpression: d.Get("inbound_provisioning.0.username_expression").(string),
+			},
+			Schedule: schedule,
+		},
+		ImportRules: &sdk.ImportRules{
+			UserCreateAndMatch: &sdk.ImportUserCreateAndMatch{
+				ExactMatchCriteria:      d.Get("inbound_provisioning.0.exact_match_criteria").(string),
+				AllowPartialMatch:       boolPtr(d.Get("inbound_provisioning.0.allow_partial_match").(bool)),
+				AutoActivateNewUsers:    boolPtr(d.Get("inbound_provisioning.0.auto_activate_new_users").(bool)),
+				AutoConfirmExactMatch:   boolPtr(d.Get("inbound_provisioning.0.auto_confirm_exact_match").(bool)),
+				AutoConfirmNewUsers:     boolPtr(d.Get("inbound_provisioning.0.auto_confirm_new_users").(bool)),
+				AutoConfirmPartialMatch: boolPtr(d.Get("inbound_provisioning.0.auto_confirm_partial_match").(bool)),
+			},
+		},
+	}
+}
+
+func flattenUserProvisioningFeature(feature *sdk.ApplicationFeature) []interface{} {
+	m := map[string]interface{}{
+		"status": feature.Status,
+	}
+	if feature.Capabilities == nil {
+		return []interface{}{m}
+	}
+	if c := feature.Capabilities.Create; c != nil && c.LifecycleCreate != nil {
+		m["create_users"] = isFeatureEnabled(c.LifecycleCreate.Status)
+	}
+	if u := feature.Capabilities.Update; u != nil {
+		if u.LifecycleDeactivate != nil {
+			m["deactivate_users"] = isFeatureEnabled(u.LifecycleDeactivate.Status)
+		}
+		if u.Profile != nil {
+			m["update_user_attributes"] = isFeatureEnabled(u.Profile.Status)
+		}
+		if u.Password != nil {
+			m["password_sync"] = isFeatureEnabled(u.Password.Status)
+			m["password_seed"] = u.Password.Seed
+			m["password_change"] = u.Password.Change
+		}
+	}
+	return []interface{}{m}
+}
+
+func flattenInboundProvisioningFeature(feature *sdk.ApplicationFeature) []interface{} {
+	m := map[string]interface{}{
+		"status": feature.Status,
+	}
+	if feature.Capabilities == nil {
+		return []interface{}{m}
+	}
+	if is := feature.Capabilities.ImportSettings; is != nil {
+		if is.Username != nil {
+			m["username_format"] = is.Username.UsernameFormat
+			m["username_expression"] = is.Username.UserNameExpression
+		}
+		if is.Schedule != nil {
+			m["schedule_enabled"] = isFeatureEnabled(is.Schedule.Status)
+			if is.Schedule.FullImport != nil {
+				m["full_import_expression"] = is.Schedule.FullImport.Expression
+				m["full_import_timezone"] = is.Schedule.FullImport.Timezone
+			}
+			if is.Schedule.IncrementalImport != nil {
+				m["incremental_import_expression"] = is.Schedule.IncrementalImport.Expression
+				m["incremental_import_timezone"] = is.Schedule.IncrementalImport.Timezone
+			}
+		}
+	}
+	if ir := feature.Capabilities.ImportRules; ir != nil && ir.UserCreateAndMatch != nil {
+		cm := ir.UserCreateAndMatch
+		m["exact_match_criteria"] = cm.ExactMatchCriteria
+		m["allow_partial_match"] = flattenOptionalBool(cm.AllowPartialMatch)
+		m["auto_activate_new_users"] = flattenOptionalBool(cm.AutoActivateNewUsers)
+		m["auto_confirm_exact_match"] = flattenOptionalBool(cm.AutoConfirmExactMatch)
+		m["auto_confirm_new_users"] = flattenOptionalBool(cm.AutoConfirmNewUsers)
+		m["auto_confirm_partial_match"] = flattenOptionalBool(cm.AutoConfirmPartialMatch)
+	}
+	return []interface{}{m}
+}
+
+func featureStatus(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
+
+func isFeatureEnabled(status string) bool {
+	return status == "ENABLED"
+}
@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAppFeatures_crud(t *testing.T) {
+	t.Skip("provisioning has to be enabled for the application before its features can be managed, which requires a reachable provisioning endpoint")
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appFeatures)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appFeatures)
+	dataSourceName := fmt.Sprintf("data.%s.test", appFeatures)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// features can't be removed from an application
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.create_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.update_user_attributes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.deactivate_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.password_sync", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "features.#"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.update_user_attributes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.password_sync", "true"),
+					resource.TestCheckResourceAttr(resourceName, "user_provisioning.0.password_seed", "OKTA"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_provisioning.0.schedule_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_provisioning.0.exact_match_criteria", "EMAIL"),
+					resource.TestCheckResourceAttr(resourceName, "inbound_provisioning.0.auto_activate_new_users", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAppFeaturesRoundTrip(t *testing.T) {
+	userProvisioning := map[string]interface{}{
+		"create_users":           true,
+		"update_user_attributes": false,
+		"deactivate_users":       true,
+		"password_sync":          true,
+		"password_seed":          "OKTA",
+		"password_change":        "CHANGE",
+	}
+	inboundProvisioning := map[string]interface{}{
+		"username_format":            "CUSTOM",
+		"username_expression":        "source.email",
+		"schedule_enabled":           true,
+		"full_import_expression":     "0 0 * * 0",
+		"full_import_timezone":       "UTC",
+		"exact_match_criteria":       "EMAIL",
+		"allow_partial_match":        false,
+		"auto_confirm_partial_match": false,
+		"auto_confirm_exact_match":   true,
+		"auto_confirm_new_users":     false,
+		"auto_activate_new_users":    true,
+	}
+	d := schema.TestResourceDataRaw(t, resourceAppFeatures().Schema, map[string]interface{}{
+		"app_id":               "0oa1",
+		"user_provisioning":    []interface{}{userProvisioning},
+		"inbound_provisioning": []interface{}{inboundProvisioning},
+	})
+
+	capabilities := buildUserProvisioningFeature(d)
+	feature := &sdk.ApplicationFeature{Capabilities: &capabilities}
+	feature.Status = "ENABLED"
+	flattened := flattenUserProvisioningFeature(feature)[0].(map[string]interface{})
+	assert.Equal(t, "ENABLED", flattened["status"])
+	delete(flattened, "status")
+	assert.Equal(t, userProvisioning, flattened)
+
+	inbound := buildInboundProvisioningFeature(d)
+	assert.Nil(t, inbound.ImportSettings.Schedule.IncrementalImport)
+	feature = &sdk.ApplicationFeature{Capabilities: &inbound}
+	flattened = flattenInboundProvisioningFeature(feature)[0].(map[string]interface{})
+	delete(flattened, "status")
+	assert.Equal(t, inboundProvisioning, flattened)
+}
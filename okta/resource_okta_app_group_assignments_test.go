@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func TestAccAppGroupAssignments_crud(t *testing.T) {
@@ -52,6 +53,56 @@ func TestAccAppGroupAssignments_crud(t *testing.T) {
 	})
 }
 
+// TestAccAppGroupAssignments_migrateFromInlineGroups follows the migration
+// guide: the deprecated inline 'groups' of an app are replaced with
+// 'skip_groups' and an okta_app_group_assignments resource, without
+// recreating the app or dropping its assignments.
+func TestAccAppGroupAssignments_migrateFromInlineGroups(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", appGroupAssignments)
+	appResourceName := fmt.Sprintf("%s.test", appOAuth)
+	mgr := newFixtureManager(appGroupAssignments)
+	inlineConfig := mgr.GetFixtures("migrate_inline.tf", ri, t)
+	standaloneConfig := mgr.GetFixtures("migrate_standalone.tf", ri, t)
+
+	group1 := fmt.Sprintf("%s.test1", group)
+	group2 := fmt.Sprintf("%s.test2", group)
+	var appID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: inlineConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(appResourceName, "groups.#", "2"),
+					func(s *terraform.State) error {
+						appID = s.RootModule().Resources[appResourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: standaloneConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureAppGroupAssignmentsExist(resourceName, group1, group2),
+					resource.TestCheckResourceAttr(appResourceName, "skip_groups", "true"),
+					resource.TestCheckNoResourceAttr(appResourceName, "groups.#"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[appResourceName].Primary.ID; id != appID {
+							return fmt.Errorf("expected app %s to be kept, got %s", appID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func ensureAppGroupAssignmentsExist(name string, groupsExpected ...string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		missingErr := fmt.Errorf("resource not found: %s", name)
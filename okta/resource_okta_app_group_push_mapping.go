@@ -0,0 +1,146 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppGroupPushMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppGroupPushMappingCreate,
+		ReadContext:   resourceAppGroupPushMappingRead,
+		UpdateContext: resourceAppGroupPushMappingUpdate,
+		DeleteContext: resourceAppGroupPushMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 2 {
+					return nil, errors.New("invalid resource import specifier. Use: terraform import <app_id>/<mapping_id>")
+				}
+				_ = d.Set("app_id", parts[0])
+				_ = d.Set("delete_target_group_on_destroy", false)
+				d.SetId(parts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app to push the group to",
+			},
+			"source_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Okta group to push",
+			},
+			"target_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"target_group_id", "target_group_name"},
+				Description:  "ID of the existing group in the app to push to",
+			},
+			"target_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"target_group_id", "target_group_name"},
+				Description:  "Name of the group to create in the app and to push to",
+				// the name is never read back, so an imported mapping only knows its target group ID
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == "" && d.Get("target_group_id").(string) != ""
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Status of the mapping, pushes only happen when it is ACTIVE",
+			},
+			"delete_target_group_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the target group in the app when the mapping is destroyed. If set to false, the group is kept in the app.",
+			},
+			"last_push": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date of the last push",
+			},
+			"error_summary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Error of the last push if it failed",
+			},
+		},
+	}
+}
+
+func resourceAppGroupPushMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mapping := sdk.GroupPushMapping{
+		SourceGroupId:   d.Get("source_group_id").(string),
+		TargetGroupId:   d.Get("target_group_id").(string),
+		TargetGroupName: d.Get("target_group_name").(string),
+		Status:          d.Get("status").(string),
+	}
+	created, _, err := getSupplementFromMetadata(m).CreateGroupPushMapping(ctx, d.Get("app_id").(string), mapping)
+	if err != nil {
+		return diag.Errorf("failed to create group push mapping: %v", err)
+	}
+	d.SetId(created.Id)
+	return resourceAppGroupPushMappingRead(ctx, d, m)
+}
+
+func resourceAppGroupPushMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	mapping, resp, err := getSupplementFromMetadata(m).GetGroupPushMapping(ctx, d.Get("app_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get group push mapping: %v", err)
+	}
+	if mapping == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("source_group_id", mapping.SourceGroupId)
+	_ = d.Set("target_group_id", mapping.TargetGroupId)
+	_ = d.Set("status", mapping.Status)
+	_ = d.Set("last_push", mapping.LastPush)
+	_ = d.Set("error_summary", mapping.ErrorSummary)
+	return nil
+}
+
+func resourceAppGroupPushMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("status") {
+		_, _, err := getSupplementFromMetadata(m).UpdateGroupPushMapping(ctx, d.Get("app_id").(string), d.Id(), d.Get("status").(string))
+		if err != nil {
+			return diag.Errorf("failed to update group push mapping: %v", err)
+		}
+	}
+	return resourceAppGroupPushMappingRead(ctx, d, m)
+}
+
+func resourceAppGroupPushMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	// only inactive mappings can be deleted
+	if d.Get("status").(string) == statusActive {
+		_, resp, err := getSupplementFromMetadata(m).UpdateGroupPushMapping(ctx, appID, d.Id(), statusInactive)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate group push mapping: %v", err)
+		}
+	}
+	resp, err := getSupplementFromMetadata(m).DeleteGroupPushMapping(ctx, appID, d.Id(), d.Get("delete_target_group_on_destroy").(bool))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete group push mapping: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAppGroupPushMapping_crud(t *testing.T) {
+	t.Skip("provisioning has to be enabled for the application before groups can be pushed, which requires a reachable provisioning endpoint")
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appGroupPushMapping)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appGroupPushMapping)
+	dataSourceName := fmt.Sprintf("data.%s.test", appGroupPushMappings)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkAppGroupPushMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "target_group_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(dataSourceName, "mappings.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mappings.0.id", resourceName, "id"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["app_id"], rs.Primary.ID), nil
+				},
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target_group_name", "delete_target_group_on_destroy"},
+			},
+		},
+	})
+}
+
+func checkAppGroupPushMappingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != appGroupPushMapping {
+			continue
+		}
+		mapping, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetGroupPushMapping(
+			context.Background(), rs.Primary.Attributes["app_id"], rs.Primary.ID)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return err
+		}
+		if mapping != nil {
+			return fmt.Errorf("group push mapping %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
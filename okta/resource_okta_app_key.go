@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// Keys of an app can't be deleted, destroying the resource only removes it
+// from the state.
+func resourceAppKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppKeyCreate,
+		ReadContext:   resourceAppKeyRead,
+		DeleteContext: resourceFuncNoOp,
+		Importer:      createNestedResourceImporter([]string{"app_id", "id"}),
+		Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app",
+			},
+			"years_valid": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          2,
+				ValidateDiagFunc: intBetween(2, 10),
+				Description:      "Number of years the certificate of the key is valid",
+			},
+		}),
+	}
+}
+
+func resourceAppKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{ValidityYears: int64(d.Get("years_valid").(int))}
+	key, _, err := getOktaClientFromMetadata(m).Application.GenerateApplicationKey(ctx, d.Get("app_id").(string), qp)
+	if err != nil {
+		return diag.Errorf("failed to generate app key: %v", err)
+	}
+	d.SetId(key.Kid)
+	return resourceAppKeyRead(ctx, d, m)
+}
+
+func resourceAppKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getOktaClientFromMetadata(m).Application.GetApplicationKey(ctx, d.Get("app_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := setJSONWebKey(d, key); err != nil {
+		return diag.Errorf("failed to set app key properties: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// The cloned key keeps its ID in the target app. Keys of an app can't be
+// deleted, destroying the resource only removes it from the state.
+func resourceAppKeyClone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppKeyCloneCreate,
+		ReadContext:   resourceAppKeyCloneRead,
+		DeleteContext: resourceFuncNoOp,
+		Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app the key is cloned from",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the key to clone",
+			},
+			"target_app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app the key is cloned to",
+			},
+		}),
+	}
+}
+
+func resourceAppKeyCloneCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{TargetAid: d.Get("target_app_id").(string)}
+	key, _, err := getOktaClientFromMetadata(m).Application.CloneApplicationKey(ctx, d.Get("app_id").(string), d.Get("key_id").(string), qp)
+	if err != nil {
+		return diag.Errorf("failed to clone app key: %v", err)
+	}
+	d.SetId(key.Kid)
+	return resourceAppKeyCloneRead(ctx, d, m)
+}
+
+func resourceAppKeyCloneRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getOktaClientFromMetadata(m).Application.GetApplicationKey(ctx, d.Get("target_app_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get cloned app key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := setJSONWebKey(d, key); err != nil {
+		return diag.Errorf("failed to set cloned app key properties: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAppKeyClone_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appKeyClone)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appKeyClone)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "okta_app_key.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_app_id", "okta_app_saml.target", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "x5t_s256", "okta_app_key.test", "x5t_s256"),
+				),
+			},
+		},
+	})
+}
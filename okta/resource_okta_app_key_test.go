@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccAppKey_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appKey)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appKey)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "app_id", "okta_app_saml.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "kid", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "x5c.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       appKeyImportStateID(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"years_valid"},
+			},
+		},
+	})
+}
+
+func appKeyImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("failed to find %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["app_id"], rs.Primary.ID), nil
+	}
+}
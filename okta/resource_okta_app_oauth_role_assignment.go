@@ -0,0 +1,275 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppOAuthRoleAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppOAuthRoleAssignmentCreate,
+		ReadContext:   resourceAppOAuthRoleAssignmentRead,
+		UpdateContext: resourceAppOAuthRoleAssignmentUpdate,
+		DeleteContext: resourceAppOAuthRoleAssignmentDelete,
+		Importer:      createNestedResourceImporter([]string{"client_id", "id"}),
+		CustomizeDiff: customdiff.All(
+			validateAppOAuthRoleAssignment,
+			// to avoid exception when removing the last target from a role assignment,
+			// the API consumer should delete the role assignment and recreate it.
+			customdiff.ForceNewIfChange("groups", func(_ context.Context, old, new, _ interface{}) bool {
+				return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+			}),
+			customdiff.ForceNewIfChange("apps", func(_ context.Context, old, new, _ interface{}) bool {
+				return old.(*schema.Set).Len() > 0 && new.(*schema.Set).Len() == 0
+			}),
+		),
+		Description: "Resource to assign an admin role to an OAuth 2.0 service app, optionally scoped to groups or apps.",
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Client ID of the OAuth 2.0 service app",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(validAdminRoles),
+				Description:      "Type of the role to assign, CUSTOM for custom roles",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"resource_set"},
+				Description:  "ID of the custom role to assign, required when type is CUSTOM",
+			},
+			"resource_set": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"role"},
+				Description:  "ID of the resource set the custom role is scoped to, required when type is CUSTOM",
+			},
+			"groups": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "List of group IDs the role is scoped to",
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"apps"},
+			},
+			"apps": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "List of app names (name represents set of app instances) or a combination of app name and app instance ID (like 'salesforce' or 'facebook.0oapsqQ6dv19pqyEo0g3') the role is scoped to",
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"groups"},
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Label of the role",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the role assignment",
+			},
+		},
+	}
+}
+
+// validateAppOAuthRoleAssignment is run at plan time and mirrors the
+// constraints the API enforces on client role assignments.
+func validateAppOAuthRoleAssignment(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	roleType := d.Get("type").(string)
+	hasRole := d.Get("role").(string) != "" || !d.NewValueKnown("role")
+	if roleType == "CUSTOM" && !hasRole {
+		return errors.New("'role' and 'resource_set' are required when 'type' is 'CUSTOM'")
+	}
+	if roleType != "CUSTOM" && hasRole {
+		return errors.New("'role' and 'resource_set' can only be set when 'type' is 'CUSTOM'")
+	}
+	if _, ok := d.GetOk("groups"); ok && !supportsGroupTargets(roleType) {
+		return fmt.Errorf("'groups' can not be set for '%s' roles", roleType)
+	}
+	if _, ok := d.GetOk("apps"); ok && roleType != "APP_ADMIN" {
+		return fmt.Errorf("'apps' can not be set for '%s' roles", roleType)
+	}
+	return nil
+}
+
+func resourceAppOAuthRoleAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clientID := d.Get("client_id").(string)
+	logger(m).Info("assigning role to OAuth app", "client_id", clientID, "type", d.Get("type").(string))
+	role, _, err := getSupplementFromMetadata(m).AssignClientRole(ctx, clientID, sdk.ClientRoleAssignment{
+		Type:        d.Get("type").(string),
+		Role:        d.Get("role").(string),
+		ResourceSet: d.Get("resource_set").(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to assign role to OAuth app: %v", err)
+	}
+	d.SetId(role.Id)
+	err = addClientRoleGroupTargets(ctx, d, m, convertInterfaceToStringSet(d.Get("groups")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = addClientRoleAppTargets(ctx, d, m, convertInterfaceToStringSet(d.Get("apps")))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceAppOAuthRoleAssignmentRead(ctx, d, m)
+}
+
+func resourceAppOAuthRoleAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	role, resp, err := getSupplementFromMetadata(m).GetClientRole(ctx, d.Get("client_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get role assigned to OAuth app: %v", err)
+	}
+	if role == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("type", role.Type)
+	_ = d.Set("role", role.Role)
+	_ = d.Set("resource_set", role.ResourceSet)
+	_ = d.Set("label", role.Label)
+	_ = d.Set("status", role.Status)
+	if supportsGroupTargets(role.Type) {
+		groups, err := listClientRoleGroupTargets(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to read group targets: %v", err)
+		}
+		_ = d.Set("groups", convertStringSliceToSet(groups))
+	}
+	if role.Type == "APP_ADMIN" {
+		apps, err := listClientRoleAppTargets(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to read app targets: %v", err)
+		}
+		_ = d.Set("apps", convertStringSliceToSet(apps))
+	}
+	return nil
+}
+
+func resourceAppOAuthRoleAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("groups") {
+		oldGroups, newGroups := d.GetChange("groups")
+		groupsToAdd, groupsToRemove := splitTargets(convertInterfaceToStringSet(newGroups), convertInterfaceToStringSet(oldGroups))
+		if err := addClientRoleGroupTargets(ctx, d, m, groupsToAdd); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := removeClientRoleGroupTargets(ctx, d, m, groupsToRemove); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("apps") {
+		oldApps, newApps := d.GetChange("apps")
+		appsToAdd, appsToRemove := splitTargets(convertInterfaceToStringSet(newApps), convertInterfaceToStringSet(oldApps))
+		if err := addClientRoleAppTargets(ctx, d, m, appsToAdd); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := removeClientRoleAppTargets(ctx, d, m, appsToRemove); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceAppOAuthRoleAssignmentRead(ctx, d, m)
+}
+
+func resourceAppOAuthRoleAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).UnassignClientRole(ctx, d.Get("client_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to unassign role from OAuth app: %v", err)
+	}
+	return nil
+}
+
+func addClientRoleGroupTargets(ctx context.Context, d *schema.ResourceData, m interface{}, groups []string) error {
+	for i := range groups {
+		_, err := getSupplementFromMetadata(m).AddClientRoleGroupTarget(ctx, d.Get("client_id").(string), d.Id(), groups[i])
+		if err != nil {
+			return fmt.Errorf("failed to add a group target to a role given to an OAuth app: %v", err)
+		}
+	}
+	return nil
+}
+
+func removeClientRoleGroupTargets(ctx context.Context, d *schema.ResourceData, m interface{}, groups []string) error {
+	for i := range groups {
+		resp, err := getSupplementFromMetadata(m).RemoveClientRoleGroupTarget(ctx, d.Get("client_id").(string), d.Id(), groups[i])
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to remove a group target from a role given to an OAuth app: %v", err)
+		}
+	}
+	return nil
+}
+
+// addClientRoleAppTargets adds app targets in the format of the 'apps'
+// attribute, either an app name or an app name and an app instance ID.
+func addClientRoleAppTargets(ctx context.Context, d *schema.ResourceData, m interface{}, apps []string) error {
+	for i := range apps {
+		app := strings.Split(apps[i], ".")
+		_, err := getSupplementFromMetadata(m).AddClientRoleAppTarget(ctx, d.Get("client_id").(string), d.Id(), app[0], strings.Join(app[1:], ""))
+		if err != nil {
+			return fmt.Errorf("failed to add an app target to an app administrator role given to an OAuth app: %v", err)
+		}
+	}
+	return nil
+}
+
+func removeClientRoleAppTargets(ctx context.Context, d *schema.ResourceData, m interface{}, apps []string) error {
+	for i := range apps {
+		app := strings.Split(apps[i], ".")
+		resp, err := getSupplementFromMetadata(m).RemoveClientRoleAppTarget(ctx, d.Get("client_id").(string), d.Id(), app[0], strings.Join(app[1:], ""))
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to remove an app target from an app administrator role given to an OAuth app: %v", err)
+		}
+	}
+	return nil
+}
+
+func listClientRoleGroupTargets(ctx context.Context, d *schema.ResourceData, m interface{}) ([]string, error) {
+	groups, _, err := getSupplementFromMetadata(m).ListClientRoleGroupTargets(ctx, d.Get("client_id").(string), d.Id(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resGroups := make([]string, len(groups))
+	for i := range groups {
+		resGroups[i] = groups[i].Id
+	}
+	return resGroups, nil
+}
+
+func listClientRoleAppTargets(ctx context.Context, d *schema.ResourceData, m interface{}) ([]string, error) {
+	apps, _, err := getSupplementFromMetadata(m).ListClientRoleAppTargets(ctx, d.Get("client_id").(string), d.Id(), nil)
+	if err != nil {
+		return nil, err
+	}
+	var resApps []string
+	for _, app := range apps {
+		if app.Id == "" {
+			resApps = append(resApps, app.Name)
+			continue
+		}
+		a := okta.NewApplication()
+		_, resp, err := getOktaClientFromMetadata(m).Application.GetApplication(ctx, app.Id, a, nil)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return nil, err
+		}
+		if a.Name == "" {
+			continue
+		}
+		resApps = append(resApps, fmt.Sprintf("%s.%s", a.Name, a.Id))
+	}
+	return resApps, nil
+}
@@ -0,0 +1,126 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAppOAuthRoleAssignment_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthRoleAssignment)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthRoleAssignment)
+	resourceAppName := fmt.Sprintf("%s.test_app", appOAuthRoleAssignment)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "client_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "HELP_DESK_ADMIN"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceAppName, "type", "APP_ADMIN"),
+					resource.TestCheckResourceAttr(resourceAppName, "apps.#", "1"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "HELP_DESK_ADMIN"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceAppName, "type", "APP_ADMIN"),
+					resource.TestCheckResourceAttr(resourceAppName, "apps.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppOAuthRoleAssignment_custom(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appOAuthRoleAssignment)
+	config := mgr.GetFixtures("custom.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appOAuthRoleAssignment)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "CUSTOM"),
+					resource.TestCheckResourceAttrPair(resourceName, "role", "okta_admin_role_custom.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_set", "okta_resource_set.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateAppOAuthRoleAssignment(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "standard role",
+			raw:  map[string]interface{}{"client_id": "abc", "type": "READ_ONLY_ADMIN"},
+		},
+		{
+			name: "custom role",
+			raw:  map[string]interface{}{"client_id": "abc", "type": "CUSTOM", "role": "cr0", "resource_set": "iam0"},
+		},
+		{
+			name:    "custom role without role",
+			raw:     map[string]interface{}{"client_id": "abc", "type": "CUSTOM"},
+			wantErr: true,
+		},
+		{
+			name:    "standard role with custom role",
+			raw:     map[string]interface{}{"client_id": "abc", "type": "HELP_DESK_ADMIN", "role": "cr0", "resource_set": "iam0"},
+			wantErr: true,
+		},
+		{
+			name: "group targets",
+			raw:  map[string]interface{}{"client_id": "abc", "type": "HELP_DESK_ADMIN", "groups": []interface{}{"00g1"}},
+		},
+		{
+			name:    "group targets on unsupported role",
+			raw:     map[string]interface{}{"client_id": "abc", "type": "SUPER_ADMIN", "groups": []interface{}{"00g1"}},
+			wantErr: true,
+		},
+		{
+			name:    "app targets on non app admin role",
+			raw:     map[string]interface{}{"client_id": "abc", "type": "USER_ADMIN", "apps": []interface{}{"facebook"}},
+			wantErr: true,
+		},
+	}
+	r := resourceAppOAuthRoleAssignment()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(tt.raw)
+			_, err := r.Diff(context.Background(), nil, config, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceAppProvisioningConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppProvisioningConnectionCreate,
+		ReadContext:   resourceAppProvisioningConnectionRead,
+		UpdateContext: resourceAppProvisioningConnectionUpdate,
+		DeleteContext: resourceAppProvisioningConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importAppProvisioningConnection,
+		},
+		CustomizeDiff: validateAppProvisioningConnection,
+		Description:   "Manages the provisioning connection of an application, deleting the resource deactivates the connection.",
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the application",
+			},
+			"auth_scheme": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.ProvisioningConnectionAuthSchemeToken, sdk.ProvisioningConnectionAuthSchemeOAuth2}),
+				Description:      "Authentication scheme of the connection. Valid values: `TOKEN`, `OAUTH2`",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Base URL of the provisioning API of the application, e.g. the SCIM server URL",
+			},
+			"token": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_id"},
+				Description:   "API token used to authenticate with the application, required when `auth_scheme` is `TOKEN`",
+			},
+			"client_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"token"},
+				Description:   "Client ID of the OAuth 2.0 app used to authorize Okta with the application, required when `auth_scheme` is `OAUTH2`",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the connection is activated",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the connection",
+			},
+		},
+	}
+}
+
+// validateAppProvisioningConnection makes sure the credentials of the auth
+// scheme are set, unknown values are checked at apply time by the API.
+func validateAppProvisioningConnection(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	switch d.Get("auth_scheme").(string) {
+	case sdk.ProvisioningConnectionAuthSchemeToken:
+		if d.Get("token").(string) == "" && d.NewValueKnown("token") {
+			return errors.New("'token' is required when 'auth_scheme' is 'TOKEN'")
+		}
+	case sdk.ProvisioningConnectionAuthSchemeOAuth2:
+		if d.Get("client_id").(string) == "" && d.NewValueKnown("client_id") {
+			return errors.New("'client_id' is required when 'auth_scheme' is 'OAUTH2'")
+		}
+	}
+	return nil
+}
+
+func importAppProvisioningConnection(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("app_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAppProvisioningConnectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := setAppProvisioningConnection(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	// the connection of the app may already be enabled, which 'activate=false' doesn't change
+	if !d.Get("enabled").(bool) {
+		if err := setAppProvisioningConnectionStatus(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(d.Get("app_id").(string))
+	return resourceAppProvisioningConnectionRead(ctx, d, m)
+}
+
+func resourceAppProvisioningConnectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	connection, resp, err := getSupplementFromMetadata(m).GetAppProvisioningConnection(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get application provisioning connection: %v", err)
+	}
+	if connection == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("auth_scheme", connection.AuthScheme)
+	_ = d.Set("base_url", connection.BaseUrl)
+	_ = d.Set("status", connection.Status)
+	_ = d.Set("enabled", connection.Status == "ENABLED")
+	if connection.Profile != nil && connection.Profile.ClientId != "" {
+		_ = d.Set("client_id", connection.Profile.ClientId)
+	}
+	return nil
+}
+
+func resourceAppProvisioningConnectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("auth_scheme", "base_url", "token", "client_id") {
+		if err := setAppProvisioningConnection(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("enabled") {
+		if err := setAppProvisioningConnectionStatus(ctx, d, m); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceAppProvisioningConnectionRead(ctx, d, m)
+}
+
+func resourceAppProvisioningConnectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeactivateAppProvisioningConnection(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to deactivate application provisioning connection: %v", err)
+	}
+	return nil
+}
+
+func setAppProvisioningConnection(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	body := sdk.ProvisioningConnectionRequest{
+		BaseUrl: d.Get("base_url").(string),
+		Profile: &sdk.ProvisioningConnectionProfile{
+			AuthScheme: d.Get("auth_scheme").(string),
+			ClientId:   d.Get("client_id").(string),
+			Token:      d.Get("token").(string),
+		},
+	}
+	qp := query.NewQueryParams(query.WithActivate(d.Get("enabled").(bool)))
+	_, _, err := getSupplementFromMetadata(m).SetAppProvisioningConnection(ctx, d.Get("app_id").(string), body, qp)
+	if err != nil {
+		return fmt.Errorf("failed to set application provisioning connection: %v", err)
+	}
+	return nil
+}
+
+func setAppProvisioningConnectionStatus(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	var err error
+	if d.Get("enabled").(bool) {
+		_, err = getSupplementFromMetadata(m).ActivateAppProvisioningConnection(ctx, d.Get("app_id").(string))
+	} else {
+		_, err = getSupplementFromMetadata(m).DeactivateAppProvisioningConnection(ctx, d.Get("app_id").(string))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change application provisioning connection status: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAppProvisioningConnection_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appProvisioningConnection)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appProvisioningConnection)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appSaml, createDoesAppExist(okta.NewSamlApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "app_id"),
+					resource.TestCheckResourceAttr(resourceName, "auth_scheme", "TOKEN"),
+					resource.TestCheckResourceAttr(resourceName, "base_url", "https://example.okta.com"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "DISABLED"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "base_url", "https://example-updated.okta.com"),
+					resource.TestCheckResourceAttr(resourceName, "token", fmt.Sprintf("testAcc_%d_updated", ri)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func TestValidateAppProvisioningConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "token",
+			raw:  map[string]interface{}{"app_id": "0oa1", "auth_scheme": "TOKEN", "token": "secret"},
+		},
+		{
+			name:    "token without token",
+			raw:     map[string]interface{}{"app_id": "0oa1", "auth_scheme": "TOKEN"},
+			wantErr: true,
+		},
+		{
+			name: "oauth2",
+			raw:  map[string]interface{}{"app_id": "0oa1", "auth_scheme": "OAUTH2", "client_id": "abc"},
+		},
+		{
+			name:    "oauth2 without client id",
+			raw:     map[string]interface{}{"app_id": "0oa1", "auth_scheme": "OAUTH2"},
+			wantErr: true,
+		},
+	}
+	r := resourceAppProvisioningConnection()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tt.raw), nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The app keeps signing with the key when the resource is destroyed.
+func resourceAppSigningKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAppSigningKeyCreate,
+		ReadContext:   resourceAppSigningKeyRead,
+		UpdateContext: resourceAppSigningKeyUpdate,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the app",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the key the app signs with",
+			},
+		},
+	}
+}
+
+func resourceAppSigningKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	appID := d.Get("app_id").(string)
+	_, err := getSupplementFromMetadata(m).SetAppSigningKeyID(ctx, appID, d.Get("key_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to set app signing key: %v", err)
+	}
+	d.SetId(appID)
+	return resourceAppSigningKeyRead(ctx, d, m)
+}
+
+func resourceAppSigningKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	kid, resp, err := getSupplementFromMetadata(m).GetAppSigningKeyID(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get app signing key: %v", err)
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("app_id", d.Id())
+	_ = d.Set("key_id", kid)
+	return nil
+}
+
+func resourceAppSigningKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, err := getSupplementFromMetadata(m).SetAppSigningKeyID(ctx, d.Id(), d.Get("key_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to update app signing key: %v", err)
+	}
+	return resourceAppSigningKeyRead(ctx, d, m)
+}
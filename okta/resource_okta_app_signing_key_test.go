@@ -0,0 +1,43 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAppSigningKey_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(appSigningKey)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", appSigningKey)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "okta_app_saml.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", "okta_app_key.test", "id"),
+				),
+			},
+			{
+				// the app reads the switched key back
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("okta_app_saml.test", "key_id", "okta_app_key.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -3,13 +3,20 @@ package okta
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+// catchAllRuleName is the name of the default rule of an app sign-on policy,
+// which can't be created or deleted.
+const catchAllRuleName = "Catch-all Rule"
+
 func resourceAppSignOnPolicyRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAppSignOnPolicyRuleCreate,
@@ -17,6 +24,7 @@ func resourceAppSignOnPolicyRule() *schema.Resource {
 		UpdateContext: resourceAppSignOnPolicyRuleUpdate,
 		DeleteContext: resourceAppSignOnPolicyRuleDelete,
 		Importer:      createPolicyRuleImporter(),
+		CustomizeDiff: validateAppSignOnPolicyRule,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -110,6 +118,13 @@ func resourceAppSignOnPolicyRule() *schema.Resource {
 				RequiredWith: []string{"device_is_registered"},
 				Description:  "If the device is managed. A device is managed if it's managed by a device management system. When managed is passed, registered must also be included and must be set to true.",
 			},
+			"device_assurances_included": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				RequiredWith: []string{"device_is_registered"},
+				Description:  "List of device assurance policy IDs the device has to comply with. When set, registered must also be included and must be set to true.",
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
 			"platform_include": {
 				Type:     schema.TypeSet,
 				Elem:     platformIncludeResource,
@@ -250,6 +265,9 @@ func resourceAppSignOnPolicyRuleRead(ctx context.Context, d *schema.ResourceData
 		if rule.Conditions.Device != nil {
 			_ = d.Set("device_is_managed", rule.Conditions.Device.Managed)
 			_ = d.Set("device_is_registered", rule.Conditions.Device.Registered)
+			if rule.Conditions.Device.Assurance != nil {
+				m["device_assurances_included"] = convertStringSliceToSetNullable(rule.Conditions.Device.Assurance.Include)
+			}
 		}
 		if rule.Conditions.People != nil {
 			if rule.Conditions.People.Users != nil {
@@ -298,7 +316,7 @@ func resourceAppSignOnPolicyRuleDelete(ctx context.Context, d *schema.ResourceDa
 		return resourceOIEOnlyFeatureError(appSignOnPolicyRule)
 	}
 
-	if d.Get("name") == "Catch-all Rule" {
+	if d.Get("name") == catchAllRuleName {
 		// You cannot delete a default rule in a policy
 		return nil
 	}
@@ -309,8 +327,39 @@ func resourceAppSignOnPolicyRuleDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
-func buildAppSignOnPolicyRule(d *schema.ResourceData) okta.AccessPolicyRule {
-	rule := okta.AccessPolicyRule{
+// validateAppSignOnPolicyRule is run at plan time and mirrors the constraints
+// the API enforces on app sign-on policy rules.
+func validateAppSignOnPolicyRule(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("name").(string) != catchAllRuleName {
+		if err := validatePolicyRuleNetwork(d); err != nil {
+			return err
+		}
+	}
+	if d.Get("device_is_managed").(bool) && !d.Get("device_is_registered").(bool) {
+		return errors.New("'device_is_registered' must be set to true when 'device_is_managed' is true")
+	}
+	if !d.NewValueKnown("constraints") {
+		return nil
+	}
+	factorMode := d.Get("factor_mode").(string)
+	for i, item := range d.Get("constraints").([]interface{}) {
+		var constraint okta.AccessPolicyConstraints
+		if err := json.Unmarshal([]byte(item.(string)), &constraint); err != nil {
+			return fmt.Errorf("'constraints.%d' is not a valid constraint object: %v", i, err)
+		}
+		if constraint.Knowledge == nil && constraint.Possession == nil {
+			return fmt.Errorf("'constraints.%d' should contain at least one of 'knowledge' or 'possession'", i)
+		}
+		if factorMode == "1FA" && constraint.Knowledge != nil && constraint.Possession != nil {
+			return fmt.Errorf("'constraints.%d' can not contain both 'knowledge' and 'possession' when 'factor_mode' is '1FA'", i)
+		}
+	}
+	return nil
+}
+
+func buildAppSignOnPolicyRule(d *schema.ResourceData) sdk.AccessPolicyRule {
+	rule := sdk.AccessPolicyRule{}
+	rule.AccessPolicyRule = okta.AccessPolicyRule{
 		Actions: &okta.AccessPolicyRuleActions{
 			AppSignOn: &okta.AccessPolicyRuleApplicationSignOn{
 				Access: d.Get("access").(string),
@@ -338,23 +387,32 @@ func buildAppSignOnPolicyRule(d *schema.ResourceData) okta.AccessPolicyRule {
 	}
 	rule.Actions.AppSignOn.VerificationMethod.Constraints = constraints
 	// if this is a default rule, the conditions attribute is read-only.
-	if d.Get("name") == "Catch-all Rule" {
+	if d.Get("name") == catchAllRuleName {
 		return rule
 	}
-	rule.Conditions = &okta.AccessPolicyRuleConditions{
-		Network: buildPolicyNetworkCondition(d),
-		Platform: &okta.PlatformPolicyRuleCondition{
-			Include: buildAccessPolicyPlatformInclude(d),
-		},
-		ElCondition: &okta.AccessPolicyRuleCustomCondition{
-			Condition: d.Get("custom_expression").(string),
+	rule.Conditions = &sdk.AccessPolicyRuleConditions{
+		AccessPolicyRuleConditions: okta.AccessPolicyRuleConditions{
+			Network: buildPolicyNetworkCondition(d),
+			Platform: &okta.PlatformPolicyRuleCondition{
+				Include: buildAccessPolicyPlatformInclude(d),
+			},
+			ElCondition: &okta.AccessPolicyRuleCustomCondition{
+				Condition: d.Get("custom_expression").(string),
+			},
 		},
 	}
 	isRegistered, ok := d.GetOk("device_is_registered")
 	if ok && isRegistered.(bool) {
-		rule.Conditions.Device = &okta.DeviceAccessPolicyRuleCondition{
-			Managed:    boolPtr(d.Get("device_is_managed").(bool)),
-			Registered: boolPtr(isRegistered.(bool)),
+		rule.Conditions.Device = &sdk.DeviceAccessPolicyRuleCondition{
+			DeviceAccessPolicyRuleCondition: okta.DeviceAccessPolicyRuleCondition{
+				Managed:    boolPtr(d.Get("device_is_managed").(bool)),
+				Registered: boolPtr(isRegistered.(bool)),
+			},
+		}
+		if assurances, ok := d.GetOk("device_assurances_included"); ok {
+			rule.Conditions.Device.Assurance = &sdk.DeviceAssuranceCondition{
+				Include: convertInterfaceToStringSetNullable(assurances),
+			}
 		}
 	}
 	usersExcluded, usersExcludedOk := d.GetOk("users_excluded")
@@ -2,12 +2,15 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -74,6 +77,92 @@ func TestAccOktaAppSignOnPolicyRule(t *testing.T) {
 	})
 }
 
+func TestAccOktaAppSignOnPolicyRule_deviceAssurance(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", appSignOnPolicyRule)
+	mgr := newFixtureManager(appSignOnPolicyRule)
+	config := mgr.GetFixtures("device_assurance.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      appSignOnPolicyRuleExists,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "device_is_registered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "device_assurances_included.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "device_assurances_included.*", fmt.Sprintf("%s.test", policyDeviceAssuranceMacOS), "id"),
+				),
+			},
+		},
+	})
+}
+
+// TestBuildAppSignOnPolicyRuleDeviceAssurance ensures the device assurance
+// condition of the sdk wrapper is sent along with the okta-sdk-golang ones.
+func TestBuildAppSignOnPolicyRuleDeviceAssurance(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppSignOnPolicyRule().Schema, map[string]interface{}{
+		"policy_id":                  "policyID",
+		"name":                       "rule",
+		"device_is_registered":       true,
+		"device_assurances_included": []interface{}{"assuranceID"},
+		"network_connection":         "ANYWHERE",
+	})
+	b, err := json.Marshal(buildAppSignOnPolicyRule(d))
+	if err != nil {
+		t.Fatalf("failed to marshal rule: %v", err)
+	}
+	var rule map[string]interface{}
+	if err := json.Unmarshal(b, &rule); err != nil {
+		t.Fatalf("failed to unmarshal rule: %v", err)
+	}
+	device := rule["conditions"].(map[string]interface{})["device"].(map[string]interface{})
+	if device["registered"] != true {
+		t.Errorf("expected the device to be required to be registered, got %v", device["registered"])
+	}
+	include := device["assurance"].(map[string]interface{})["include"].([]interface{})
+	if len(include) != 1 || include[0] != "assuranceID" {
+		t.Errorf("unexpected device assurances %v", include)
+	}
+	if _, ok := rule["conditions"].(map[string]interface{})["network"]; !ok {
+		t.Error("expected the network condition to be kept")
+	}
+}
+
+func TestAccOktaAppSignOnPolicyRule_planValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      appSignOnPolicyRuleExists,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  policy_id   = "garbageID"
+  name        = "%s"
+  factor_mode = "1FA"
+  constraints = [
+    jsonencode({
+      "knowledge" : {
+        "types" : ["password"]
+      },
+      "possession" : {
+        "deviceBound" : "REQUIRED"
+      }
+    })
+  ]
+}`, appSignOnPolicyRule, buildResourceName(acctest.RandInt())),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'constraints.0' can not contain both 'knowledge' and 'possession' when 'factor_mode' is '1FA'`),
+			},
+		},
+	})
+}
+
 func appSignOnPolicyRuleExists(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != appSignOnPolicyRule {
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceAppUser() *schema.Resource {
@@ -82,6 +85,7 @@ func resourceAppUser() *schema.Resource {
 				Default:     false,
 				Description: "Retain the user assignment on destroy. If set to true, the resource will be removed from state but not from the Okta app.",
 			},
+			"max_wait_seconds": maxWaitSchema("Maximum number of seconds to wait after the user is assigned until the assignment is listed in the user's apps. Set to `0` to not wait. Default is `30`."),
 		},
 	}
 }
@@ -114,6 +118,7 @@ func resourceAppUserCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("failed to assign user to application: %v", err)
 	}
 	d.SetId(u.Id)
+	waitForAppUserAssignment(ctx, d, m)
 	return resourceAppUserRead(ctx, d, m)
 }
 
@@ -224,3 +229,44 @@ func getAppUser(d *schema.ResourceData) *okta.AppUser {
 		Profile: profile,
 	}
 }
+
+// defaultAppUserMaxWait is how long an app user resource waits by default for
+// the assignment to be listed after it is created.
+const defaultAppUserMaxWait = 30 * time.Second
+
+// waitForAppUserAssignment polls the apps of the user until the assigned app
+// is listed, since app listings are eventually consistent. A timeout is only
+// logged, the assignment itself has been created successfully.
+func waitForAppUserAssignment(ctx context.Context, d *schema.ResourceData, m interface{}) {
+	client := getOktaClientFromMetadata(m)
+	appID := d.Get("app_id").(string)
+	userID := d.Get("user_id").(string)
+	qp := &query.Params{Filter: fmt.Sprintf(`user.id eq "%s"`, userID), Limit: defaultPaginationLimit}
+	err := waitForConsistency(ctx, getMaxWait(d, defaultAppUserMaxWait), func() (bool, error) {
+		apps, resp, err := client.Application.ListApplications(ctx, qp)
+		if err != nil {
+			return false, fmt.Errorf("failed to list user's applications: %v", err)
+		}
+		for _, app := range apps {
+			if app.(*okta.Application).Id == appID {
+				return true, nil
+			}
+		}
+		for resp.HasNextPage() {
+			var nextApps []*okta.Application
+			resp, err = resp.Next(ctx, &nextApps)
+			if err != nil {
+				return false, fmt.Errorf("failed to list user's applications: %v", err)
+			}
+			for _, app := range nextApps {
+				if app.Id == appID {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		logger(m).Warn("application assignment is not listed yet", "app", appID, "user", userID, "error", err)
+	}
+}
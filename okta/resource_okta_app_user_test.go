@@ -79,6 +79,64 @@ func TestAccOktaAppUser_crud(t *testing.T) {
 	})
 }
 
+// TestAccOktaAppUser_migrateFromInlineUsers follows the migration guide: the
+// existing assignment of the deprecated inline 'users' of an app is imported
+// into an okta_app_user resource before the app skips its users, so the
+// assignment is never removed.
+func TestAccOktaAppUser_migrateFromInlineUsers(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", appUser)
+	appName := fmt.Sprintf("%s.test", appOAuth)
+	userName := fmt.Sprintf("%s.test", user)
+	mgr := newFixtureManager(appUser)
+	inline := mgr.GetFixtures("migrate_inline.tf", ri, t)
+	standalone := mgr.GetFixtures("migrate_standalone.tf", ri, t)
+	var appID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkAppUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: inline,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(appName, "users.#", "1"),
+					func(s *terraform.State) error {
+						appID = s.RootModule().Resources[appName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config:             standalone,
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/%s", s.RootModule().Resources[appName].Primary.ID,
+						s.RootModule().Resources[userName].Primary.ID), nil
+				},
+			},
+			{
+				Config: standalone,
+				Check: resource.ComposeTestCheckFunc(
+					ensureAppUserExists(resourceName),
+					resource.TestCheckResourceAttr(appName, "skip_users", "true"),
+					resource.TestCheckNoResourceAttr(appName, "users.#"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[appName].Primary.ID; id != appID {
+							return fmt.Errorf("expected app %s to be kept, got %s", appID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccOktaAppUser_retain(t *testing.T) {
 	ri := acctest.RandInt()
 	resourceName := fmt.Sprintf("%s.test", appUser)
@@ -0,0 +1,165 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func resourceAuthServerKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthServerKeyRotationCreate,
+		ReadContext:   resourceAuthServerKeyRotationRead,
+		UpdateContext: resourceAuthServerKeyRotationUpdate,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: rotateExpiredAuthServerKey,
+		Description: "Rotates the signing keys of an authorization server on creation, whenever 'rotation_trigger' changes " +
+			"or once the active key is older than 'max_age'. Rotation can not be undone, delete only removes the resource from the state.",
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Auth server ID",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, the keys are rotated whenever it changes",
+			},
+			"max_age": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsDuration,
+				Description:      "Maximum age of the active key as a duration, e.g. '720h'. The keys are rotated by the first apply after it is exceeded",
+			},
+			"last_rotated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date of the last rotation of the keys",
+			},
+			"active_key": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Key used to sign the tokens",
+				Elem:        authServerKeySchema,
+			},
+			"next_key": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Key becoming active on the next rotation",
+				Elem:        authServerKeySchema,
+			},
+		},
+	}
+}
+
+// rotateExpiredAuthServerKey plans an update, hence a rotation, when the
+// active key is older than 'max_age'.
+func rotateExpiredAuthServerKey(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if authServerKeyExpired(d.Get("last_rotated").(string), d.Get("max_age").(string), time.Now()) {
+		for _, k := range []string{"last_rotated", "active_key", "next_key"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func authServerKeyExpired(lastRotated, maxAge string, now time.Time) bool {
+	if lastRotated == "" || maxAge == "" {
+		return false
+	}
+	rotated, err := time.Parse(time.RFC3339, lastRotated)
+	if err != nil {
+		return false
+	}
+	age, err := time.ParseDuration(maxAge)
+	if err != nil {
+		return false
+	}
+	return now.Sub(rotated) >= age
+}
+
+func resourceAuthServerKeyRotationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := rotateAuthServerKeys(ctx, d, m); err != nil {
+		return err
+	}
+	d.SetId(d.Get("auth_server_id").(string))
+	return resourceAuthServerKeyRotationRead(ctx, d, m)
+}
+
+func resourceAuthServerKeyRotationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	authServer, resp, err := client.AuthorizationServer.GetAuthorizationServer(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get authorization server: %v", err)
+	}
+	if authServer == nil {
+		d.SetId("")
+		return nil
+	}
+	keys, _, err := client.AuthorizationServer.ListAuthorizationServerKeys(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to list authorization server keys: %v", err)
+	}
+	_ = d.Set("auth_server_id", d.Id())
+	active := findAuthServerKey(keys, "ACTIVE")
+	next := findAuthServerKey(keys, "NEXT")
+	_ = d.Set("last_rotated", authServerLastRotated(authServer, active))
+	arr := map[string]interface{}{"active_key": nil, "next_key": nil}
+	if active != nil {
+		arr["active_key"] = []interface{}{flattenAuthServerKey(active)}
+	}
+	if next != nil {
+		arr["next_key"] = []interface{}{flattenAuthServerKey(next)}
+	}
+	err = setNonPrimitives(d, arr)
+	if err != nil {
+		return diag.Errorf("failed to set authorization server keys: %v", err)
+	}
+	return nil
+}
+
+// authServerLastRotated returns the date of the last rotation of the keys of
+// the auth server. The active key can't be used once the keys were rotated: it
+// is the previous NEXT key, created by the rotation before. Its creation date
+// is only used for auth servers whose keys were never rotated.
+func authServerLastRotated(authServer *okta.AuthorizationServer, active *okta.JsonWebKey) string {
+	if authServer.Credentials != nil && authServer.Credentials.Signing != nil && authServer.Credentials.Signing.LastRotated != nil {
+		return authServer.Credentials.Signing.LastRotated.UTC().Format(time.RFC3339)
+	}
+	if active != nil && active.Created != nil {
+		return active.Created.UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+func resourceAuthServerKeyRotationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	oldLastRotated, _ := d.GetChange("last_rotated")
+	if d.HasChange("rotation_trigger") || authServerKeyExpired(oldLastRotated.(string), d.Get("max_age").(string), time.Now()) {
+		if err := rotateAuthServerKeys(ctx, d, m); err != nil {
+			return err
+		}
+	}
+	return resourceAuthServerKeyRotationRead(ctx, d, m)
+}
+
+func rotateAuthServerKeys(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("rotating authorization server keys", "auth_server_id", d.Get("auth_server_id").(string))
+	_, _, err := getOktaClientFromMetadata(m).AuthorizationServer.RotateAuthorizationServerKeys(ctx, d.Get("auth_server_id").(string), okta.JwkUse{Use: "sig"})
+	if err != nil {
+		return diag.Errorf("failed to rotate authorization server keys: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOktaAuthServerKeyRotation_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authServerKeyRotation)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", authServerKeyRotation)
+	var activeKid string
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "auth_server_id", "okta_auth_server.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "active_key.0.status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "next_key.0.status", "NEXT"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_rotated"),
+					func(s *terraform.State) error {
+						activeKid = s.RootModule().Resources[resourceName].Primary.Attributes["active_key.0.kid"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "active_key.0.status", "ACTIVE"),
+					func(s *terraform.State) error {
+						if kid := s.RootModule().Resources[resourceName].Primary.Attributes["active_key.0.kid"]; kid == activeKid {
+							return fmt.Errorf("expected the keys to be rotated, active key is still %s", kid)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAuthServerKeyExpired(t *testing.T) {
+	now := time.Date(2022, 11, 30, 12, 0, 0, 0, time.UTC)
+	assert.False(t, authServerKeyExpired("", "720h", now))
+	assert.False(t, authServerKeyExpired("2022-11-29T12:00:00Z", "", now))
+	assert.False(t, authServerKeyExpired("2022-11-29T12:00:00Z", "48h", now))
+	assert.True(t, authServerKeyExpired("2022-11-28T12:00:00Z", "48h", now))
+	assert.True(t, authServerKeyExpired("2022-10-01T12:00:00Z", "720h", now))
+}
+
+// TestAuthServerKeyExpired_afterRotation covers the apply right after a
+// rotation: the promoted key was created by the previous rotation, so it is
+// already older than max_age, but the keys must not be rotated again.
+func TestAuthServerKeyExpired_afterRotation(t *testing.T) {
+	now := time.Date(2022, 11, 30, 12, 0, 0, 0, time.UTC)
+	promotedKeyCreated := now.Add(-31 * 24 * time.Hour)
+	rotated := now.Add(-time.Minute)
+	authServer := &okta.AuthorizationServer{
+		Credentials: &okta.AuthorizationServerCredentials{
+			Signing: &okta.AuthorizationServerCredentialsSigningConfig{LastRotated: &rotated},
+		},
+	}
+	active := &okta.JsonWebKey{Created: &promotedKeyCreated}
+
+	lastRotated := authServerLastRotated(authServer, active)
+	assert.Equal(t, "2022-11-30T11:59:00Z", lastRotated)
+	assert.False(t, authServerKeyExpired(lastRotated, "720h", now))
+
+	// keys which were never rotated fall back to the creation of the active key
+	lastRotated = authServerLastRotated(&okta.AuthorizationServer{}, active)
+	assert.Equal(t, promotedKeyCreated.Format(time.RFC3339), lastRotated)
+	assert.True(t, authServerKeyExpired(lastRotated, "720h", now))
+}
@@ -17,6 +17,7 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 		UpdateContext: resourceAuthServerPolicyRuleUpdate,
 		DeleteContext: resourceAuthServerPolicyRuleDelete,
 		Importer:      createNestedResourceImporter([]string{"auth_server_id", "policy_id", "id"}),
+		CustomizeDiff: validateAuthServerPolicyRule,
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Type:        schema.TypeString,
@@ -109,10 +110,6 @@ func resourceAuthServerPolicyRuleCreate(ctx context.Context, d *schema.ResourceD
 	oktaMutexKV.Lock(authServerPolicyRule)
 	defer oktaMutexKV.Unlock(authServerPolicyRule)
 
-	err := validateAuthServerPolicyRule(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	resp, _, err := getOktaClientFromMetadata(m).AuthorizationServer.CreateAuthorizationServerPolicyRule(
 		ctx, d.Get("auth_server_id").(string), d.Get("policy_id").(string), buildAuthServerPolicyRule(d))
 	if err != nil {
@@ -161,11 +158,7 @@ func resourceAuthServerPolicyRuleUpdate(ctx context.Context, d *schema.ResourceD
 	oktaMutexKV.Lock(authServerPolicyRule)
 	defer oktaMutexKV.Unlock(authServerPolicyRule)
 
-	err := validateAuthServerPolicyRule(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	_, _, err = getOktaClientFromMetadata(m).AuthorizationServer.UpdateAuthorizationServerPolicyRule(
+	_, _, err := getOktaClientFromMetadata(m).AuthorizationServer.UpdateAuthorizationServerPolicyRule(
 		ctx,
 		d.Get("auth_server_id").(string),
 		d.Get("policy_id").(string), d.Id(),
@@ -277,8 +270,10 @@ func setPeopleAssignments(d *schema.ResourceData, c *okta.PolicyPeopleCondition)
 	})
 }
 
-func validateAuthServerPolicyRule(d *schema.ResourceData) error {
-	if w, ok := d.GetOk("grant_type_whitelist"); ok {
+// validateAuthServerPolicyRule is run at plan time and mirrors the constraints
+// the API enforces on auth server policy rules.
+func validateAuthServerPolicyRule(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if w, ok := d.GetOk("grant_type_whitelist"); ok && newValuesKnown(d, "grant_type_whitelist", "user_whitelist", "group_whitelist") {
 		for _, v := range convertInterfaceToStringSet(w) {
 			if v != implicit {
 				continue
@@ -290,6 +285,9 @@ func validateAuthServerPolicyRule(d *schema.ResourceData) error {
 			}
 		}
 	}
+	if !newValuesKnown(d, "refresh_token_lifetime_minutes", "access_token_lifetime_minutes", "refresh_token_window_minutes") {
+		return nil
+	}
 	rtlm := d.Get("refresh_token_lifetime_minutes").(int)
 	atlm := d.Get("access_token_lifetime_minutes").(int)
 	rtwm := d.Get("refresh_token_window_minutes").(int)
@@ -27,7 +27,7 @@ func resourceAuthenticator() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				Description:      "A human-readable string that identifies the Authenticator",
-				ValidateDiagFunc: elemInSlice(sdk.AuthenticatorProviders),
+				ValidateDiagFunc: elemInSlice(append([]string{sdk.CustomAppFactor}, sdk.AuthenticatorProviders...)),
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -50,7 +50,7 @@ func resourceAuthenticator() *schema.Resource {
 			"provider_json": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				Description:      "Provider in JSON format",
+				Description:      "Provider in JSON format. For a custom app authenticator it references the push providers in its configuration's 'apns' and 'fcm' objects",
 				ValidateDiagFunc: stringIsJSON,
 				StateFunc:        normalizeDataJSON,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -154,18 +154,21 @@ func resourceAuthenticator() *schema.Resource {
 // /api/v1/authenticators` to create the authenticator (hard create) is to be
 // performed. Thereafter, that authenticator is never deleted, it is only
 // deactivated (soft delete). Therefore, if the authenticator already exists
-// create is just a soft import of an existing authenticator.
+// create is just a soft import of an existing authenticator. Several custom app
+// authenticators can exist in an org, so those are always hard created.
 func resourceAuthenticatorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	if isClassicOrg(m) {
 		return resourceOIEOnlyFeatureError(authenticator)
 	}
 
-	var err error
+	var authenticator *okta.Authenticator
 	// soft create if the authenticator already exists
-	authenticator, _ := findAuthenticator(ctx, m, "", d.Get("key").(string))
+	if key := d.Get("key").(string); key != sdk.CustomAppFactor {
+		authenticator, _ = findAuthenticator(ctx, m, "", key)
+	}
 	if authenticator == nil {
 		// otherwise hard create
-		authenticator, err = buildAuthenticator(d)
+		body, err := buildAuthenticator(d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -173,7 +176,7 @@ func resourceAuthenticatorCreate(ctx context.Context, d *schema.ResourceData, m
 		qp := &query.Params{
 			Activate: boolPtr(activate),
 		}
-		authenticator, _, err = getOktaClientFromMetadata(m).Authenticator.CreateAuthenticator(ctx, *authenticator, qp)
+		authenticator, _, err = getSupplementFromMetadata(m).CreateAuthenticator(ctx, *body, qp)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -227,7 +230,7 @@ func resourceAuthenticatorUpdate(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.Errorf("failed to update authenticator: %v", err)
 	}
-	_, _, err = getOktaClientFromMetadata(m).Authenticator.UpdateAuthenticator(ctx, d.Id(), *authenticator)
+	_, _, err = getSupplementFromMetadata(m).UpdateAuthenticator(ctx, d.Id(), *authenticator)
 	if err != nil {
 		return diag.Errorf("failed to update authenticator: %v", err)
 	}
@@ -261,35 +264,41 @@ func resourceAuthenticatorDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func buildAuthenticator(d *schema.ResourceData) (*okta.Authenticator, error) {
-	authenticator := okta.Authenticator{
-		Type: d.Get("type").(string),
-		Id:   d.Id(),
-		Key:  d.Get("key").(string),
-		Name: d.Get("name").(string),
+func buildAuthenticator(d *schema.ResourceData) (*sdk.Authenticator, error) {
+	authenticator := sdk.Authenticator{
+		Authenticator: okta.Authenticator{
+			Type: d.Get("type").(string),
+			Id:   d.Id(),
+			Key:  d.Get("key").(string),
+			Name: d.Get("name").(string),
+		},
 	}
 	if d.Get("type").(string) == "security_key" {
-		authenticator.Provider = &okta.AuthenticatorProvider{
+		authenticator.Provider = &sdk.AuthenticatorProvider{
 			Type: d.Get("provider_type").(string),
-			Configuration: &okta.AuthenticatorProviderConfiguration{
-				HostName:     d.Get("provider_hostname").(string),
-				AuthPort:     d.Get("provider_auth_port").(int64),
-				InstanceId:   d.Get("provider_instance_id").(string),
-				SharedSecret: d.Get("provider_shared_secret").(string),
-				UserNameTemplate: &okta.AuthenticatorProviderConfigurationUserNamePlate{
-					Template: "",
+			Configuration: &sdk.AuthenticatorProviderConfiguration{
+				AuthenticatorProviderConfiguration: okta.AuthenticatorProviderConfiguration{
+					HostName:     d.Get("provider_hostname").(string),
+					AuthPort:     d.Get("provider_auth_port").(int64),
+					InstanceId:   d.Get("provider_instance_id").(string),
+					SharedSecret: d.Get("provider_shared_secret").(string),
+					UserNameTemplate: &okta.AuthenticatorProviderConfigurationUserNamePlate{
+						Template: "",
+					},
 				},
 			},
 		}
 	} else if d.Get("type").(string) == "DUO" {
-		authenticator.Provider = &okta.AuthenticatorProvider{
+		authenticator.Provider = &sdk.AuthenticatorProvider{
 			Type: d.Get("provider_type").(string),
-			Configuration: &okta.AuthenticatorProviderConfiguration{
-				Host:           d.Get("provider_host").(string),
-				SecretKey:      d.Get("provider_secret_key").(string),
-				IntegrationKey: d.Get("provider_integration_key").(string),
-				UserNameTemplate: &okta.AuthenticatorProviderConfigurationUserNamePlate{
-					Template: d.Get("provider_user_name_template").(string),
+			Configuration: &sdk.AuthenticatorProviderConfiguration{
+				AuthenticatorProviderConfiguration: okta.AuthenticatorProviderConfiguration{
+					Host:           d.Get("provider_host").(string),
+					SecretKey:      d.Get("provider_secret_key").(string),
+					IntegrationKey: d.Get("provider_integration_key").(string),
+					UserNameTemplate: &okta.AuthenticatorProviderConfigurationUserNamePlate{
+						Template: d.Get("provider_user_name_template").(string),
+					},
 				},
 			},
 		}
@@ -305,7 +314,7 @@ func buildAuthenticator(d *schema.ResourceData) (*okta.Authenticator, error) {
 	}
 
 	if p, ok := d.GetOk("provider_json"); ok {
-		var provider okta.AuthenticatorProvider
+		var provider sdk.AuthenticatorProvider
 		err := json.Unmarshal([]byte(p.(string)), &provider)
 		if err != nil {
 			return nil, err
@@ -365,7 +374,7 @@ func establishAuthenticator(authenticator *okta.Authenticator, d *schema.Resourc
 			_ = d.Set("provider_instance_id", authenticator.Provider.Configuration.InstanceId)
 		}
 
-		if authenticator.Provider.Configuration.UserNameTemplate != nil {
+		if authenticator.Provider.Configuration != nil && authenticator.Provider.Configuration.UserNameTemplate != nil {
 			_ = d.Set("provider_user_name_template", authenticator.Provider.Configuration.UserNameTemplate.Template)
 		}
 
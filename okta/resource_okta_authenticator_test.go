@@ -1,6 +1,7 @@
 package okta
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -158,3 +159,69 @@ resource "okta_authenticator" "test" {
 		},
 	})
 }
+
+func TestAccOktaAuthenticator_customApp(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(authenticator)
+	config := mgr.GetFixtures("custom_app.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", authenticator)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "key", "custom_app"),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "PUSH"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildAuthenticatorCustomApp(t *testing.T) {
+	d := resourceAuthenticator().TestResourceData()
+	_ = d.Set("key", "custom_app")
+	_ = d.Set("name", "Custom App")
+	_ = d.Set("provider_json", `{"type":"PUSH","configuration":{"apns":{"id":"ppc1","appBundleId":"com.example"},"fcm":{"id":"ppc2"}}}`)
+	authenticator, err := buildAuthenticator(d)
+	if err != nil {
+		t.Fatalf("failed to build authenticator: %v", err)
+	}
+	b, err := json.Marshal(authenticator)
+	if err != nil {
+		t.Fatalf("failed to marshal authenticator: %v", err)
+	}
+	var body struct {
+		Key      string `json:"key"`
+		Provider struct {
+			Configuration struct {
+				Apns struct {
+					Id          string `json:"id"`
+					AppBundleId string `json:"appBundleId"`
+				} `json:"apns"`
+				Fcm struct {
+					Id string `json:"id"`
+				} `json:"fcm"`
+			} `json:"configuration"`
+		} `json:"provider"`
+	}
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatalf("failed to unmarshal authenticator: %v", err)
+	}
+	if body.Key != "custom_app" {
+		t.Errorf("expected key custom_app, got %q", body.Key)
+	}
+	if body.Provider.Configuration.Apns.Id != "ppc1" || body.Provider.Configuration.Apns.AppBundleId != "com.example" {
+		t.Errorf("expected APNs push provider to be referenced, got %s", b)
+	}
+	if body.Provider.Configuration.Fcm.Id != "ppc2" {
+		t.Errorf("expected FCM push provider to be referenced, got %s", b)
+	}
+}
@@ -0,0 +1,91 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceBrandErrorPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBrandErrorPageCreate,
+		ReadContext:   resourceBrandErrorPageRead,
+		UpdateContext: resourceBrandErrorPageUpdate,
+		DeleteContext: resourceBrandErrorPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBrandPage,
+		},
+		Description: "Manages the customized error page of a brand, deleting the resource resets the page to the default one.",
+		Schema:      brandPageSchema,
+	}
+}
+
+func resourceBrandErrorPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandErrorPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("brand_id").(string))
+	return resourceBrandErrorPageRead(ctx, d, m)
+}
+
+func resourceBrandErrorPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	page, resp, err := getSupplementFromMetadata(m).GetErrorPage(ctx, d.Id(), brandPageVariant(d))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get brand error page: %v", err)
+	}
+	if page == nil {
+		d.SetId("")
+		return nil
+	}
+	defaultPage, _, err := getSupplementFromMetadata(m).GetErrorPage(ctx, d.Id(), sdk.BrandPageDefault)
+	if err != nil {
+		return diag.Errorf("failed to get default brand error page: %v", err)
+	}
+	_ = d.Set("brand_id", d.Id())
+	_ = d.Set("page_content", brandPageContent(d, page.PageContent, defaultPage.PageContent))
+	err = setNonPrimitives(d, map[string]interface{}{
+		"content_security_policy_setting": flattenContentSecurityPolicySetting(page.ContentSecurityPolicySetting),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set brand error page properties: %v", err)
+	}
+	return nil
+}
+
+func resourceBrandErrorPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandErrorPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBrandErrorPageRead(ctx, d, m)
+}
+
+func resourceBrandErrorPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteErrorPage(ctx, d.Id(), brandPageVariant(d))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to reset brand error page: %v", err)
+	}
+	return nil
+}
+
+func replaceBrandErrorPage(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	brandID := d.Get("brand_id").(string)
+	page := sdk.ErrorPage{
+		PageContent:                  d.Get("page_content").(string),
+		ContentSecurityPolicySetting: buildContentSecurityPolicySetting(d),
+	}
+	if page.PageContent == "" {
+		defaultPage, _, err := getSupplementFromMetadata(m).GetErrorPage(ctx, brandID, sdk.BrandPageDefault)
+		if err != nil {
+			return fmt.Errorf("failed to get default brand error page: %v", err)
+		}
+		page.PageContent = defaultPage.PageContent
+	}
+	_, _, err := getSupplementFromMetadata(m).ReplaceErrorPage(ctx, brandID, brandPageVariant(d), page)
+	if err != nil {
+		return fmt.Errorf("failed to replace brand error page: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceOktaBrandErrorPage_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(brandErrorPage)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", brandErrorPage)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// deleting the page resets it to the default page
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "brand_id"),
+					resource.TestCheckResourceAttr(resourceName, "page_content", ""),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.0.mode", "report_only"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.0.src_list.#", "1"),
+				),
+			},
+			{
+				// removing the page content resets the page to the default content
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "page_content", ""),
+				),
+			},
+		},
+	})
+}
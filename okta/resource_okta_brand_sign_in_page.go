@@ -0,0 +1,261 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceBrandSignInPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBrandSignInPageCreate,
+		ReadContext:   resourceBrandSignInPageRead,
+		UpdateContext: resourceBrandSignInPageUpdate,
+		DeleteContext: resourceBrandSignInPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBrandPage,
+		},
+		Description: "Manages the customized sign-in page of a brand, deleting the resource resets the page to the default one.",
+		Schema: buildSchema(brandPageSchema, map[string]*schema.Schema{
+			"widget_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The version of the Sign-In Widget, e.g. `*`, `7` or `7.8`",
+			},
+			"widget_customizations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Labels and links of the Sign-In Widget",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authenticator_page_custom_link_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"authenticator_page_custom_link_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"classic_recovery_flow_email_or_username_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"custom_link_1_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"custom_link_1_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"custom_link_2_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"custom_link_2_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"forgot_password_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"forgot_password_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"help_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"help_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password_info_tip": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"password_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"show_password_visibility_toggle": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"show_user_identifier": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"sign_in_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"unlock_account_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"unlock_account_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"username_info_tip": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"username_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"widget_generation": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: elemInSlice([]string{"G2", "G3"}),
+							Description:      "Generation of the Sign-In Widget. Valid values: `G2`, `G3`",
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceBrandSignInPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandSignInPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("brand_id").(string))
+	return resourceBrandSignInPageRead(ctx, d, m)
+}
+
+func resourceBrandSignInPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	page, resp, err := getSupplementFromMetadata(m).GetSignInPage(ctx, d.Id(), brandPageVariant(d))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get brand sign-in page: %v", err)
+	}
+	if page == nil {
+		d.SetId("")
+		return nil
+	}
+	defaultPage, _, err := getSupplementFromMetadata(m).GetSignInPage(ctx, d.Id(), sdk.BrandPageDefault)
+	if err != nil {
+		return diag.Errorf("failed to get default brand sign-in page: %v", err)
+	}
+	_ = d.Set("brand_id", d.Id())
+	_ = d.Set("page_content", brandPageContent(d, page.PageContent, defaultPage.PageContent))
+	_ = d.Set("widget_version", page.WidgetVersion)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"content_security_policy_setting": flattenContentSecurityPolicySetting(page.ContentSecurityPolicySetting),
+		"widget_customizations":           flattenSignInPageWidgetCustomizations(page.WidgetCustomizations),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set brand sign-in page properties: %v", err)
+	}
+	return nil
+}
+
+func resourceBrandSignInPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandSignInPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBrandSignInPageRead(ctx, d, m)
+}
+
+func resourceBrandSignInPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteSignInPage(ctx, d.Id(), brandPageVariant(d))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to reset brand sign-in page: %v", err)
+	}
+	return nil
+}
+
+func replaceBrandSignInPage(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	brandID := d.Get("brand_id").(string)
+	page := buildSignInPage(d)
+	if page.PageContent == "" {
+		defaultPage, _, err := getSupplementFromMetadata(m).GetSignInPage(ctx, brandID, sdk.BrandPageDefault)
+		if err != nil {
+			return fmt.Errorf("failed to get default brand sign-in page: %v", err)
+		}
+		page.PageContent = defaultPage.PageContent
+	}
+	_, _, err := getSupplementFromMetadata(m).ReplaceSignInPage(ctx, brandID, brandPageVariant(d), page)
+	if err != nil {
+		return fmt.Errorf("failed to replace brand sign-in page: %v", err)
+	}
+	return nil
+}
+
+func buildSignInPage(d *schema.ResourceData) sdk.SignInPage {
+	page := sdk.SignInPage{
+		PageContent:                  d.Get("page_content").(string),
+		ContentSecurityPolicySetting: buildContentSecurityPolicySetting(d),
+		WidgetVersion:                d.Get("widget_version").(string),
+	}
+	if _, ok := d.GetOk("widget_customizations.0"); ok {
+		page.WidgetCustomizations = &sdk.SignInPageWidgetCustomizations{
+			AuthenticatorPageCustomLinkLabel:        d.Get("widget_customizations.0.authenticator_page_custom_link_label").(string),
+			AuthenticatorPageCustomLinkUrl:          d.Get("widget_customizations.0.authenticator_page_custom_link_url").(string),
+			ClassicRecoveryFlowEmailOrUsernameLabel: d.Get("widget_customizations.0.classic_recovery_flow_email_or_username_label").(string),
+			CustomLink1Label:                        d.Get("widget_customizations.0.custom_link_1_label").(string),
+			CustomLink1Url:                          d.Get("widget_customizations.0.custom_link_1_url").(string),
+			CustomLink2Label:                        d.Get("widget_customizations.0.custom_link_2_label").(string),
+			CustomLink2Url:                          d.Get("widget_customizations.0.custom_link_2_url").(string),
+			ForgotPasswordLabel:                     d.Get("widget_customizations.0.forgot_password_label").(string),
+			ForgotPasswordUrl:                       d.Get("widget_customizations.0.forgot_password_url").(string),
+			HelpLabel:                               d.Get("widget_customizations.0.help_label").(string),
+			HelpUrl:                                 d.Get("widget_customizations.0.help_url").(string),
+			PasswordInfoTip:                         d.Get("widget_customizations.0.password_info_tip").(string),
+			PasswordLabel:                           d.Get("widget_customizations.0.password_label").(string),
+			ShowPasswordVisibilityToggle:            boolPtr(d.Get("widget_customizations.0.show_password_visibility_toggle").(bool)),
+			ShowUserIdentifier:                      boolPtr(d.Get("widget_customizations.0.show_user_identifier").(bool)),
+			SignInLabel:                             d.Get("widget_customizations.0.sign_in_label").(string),
+			UnlockAccountLabel:                      d.Get("widget_customizations.0.unlock_account_label").(string),
+			UnlockAccountUrl:                        d.Get("widget_customizations.0.unlock_account_url").(string),
+			UsernameInfoTip:                         d.Get("widget_customizations.0.username_info_tip").(string),
+			UsernameLabel:                           d.Get("widget_customizations.0.username_label").(string),
+			WidgetGeneration:                        d.Get("widget_customizations.0.widget_generation").(string),
+		}
+	}
+	return page
+}
+
+func flattenSignInPageWidgetCustomizations(wc *sdk.SignInPageWidgetCustomizations) []interface{} {
+	if wc == nil {
+		return nil
+	}
+	m := map[string]interface{}{
+		"authenticator_page_custom_link_label":          wc.AuthenticatorPageCustomLinkLabel,
+		"authenticator_page_custom_link_url":            wc.AuthenticatorPageCustomLinkUrl,
+		"classic_recovery_flow_email_or_username_label": wc.ClassicRecoveryFlowEmailOrUsernameLabel,
+		"custom_link_1_label":                           wc.CustomLink1Label,
+		"custom_link_1_url":                             wc.CustomLink1Url,
+		"custom_link_2_label":                           wc.CustomLink2Label,
+		"custom_link_2_url":                             wc.CustomLink2Url,
+		"forgot_password_label":                         wc.ForgotPasswordLabel,
+		"forgot_password_url":                           wc.ForgotPasswordUrl,
+		"help_label":                                    wc.HelpLabel,
+		"help_url":                                      wc.HelpUrl,
+		"password_info_tip":                             wc.PasswordInfoTip,
+		"password_label":                                wc.PasswordLabel,
+		"sign_in_label":                                 wc.SignInLabel,
+		"unlock_account_label":                          wc.UnlockAccountLabel,
+		"unlock_account_url":                            wc.UnlockAccountUrl,
+		"username_info_tip":                             wc.UsernameInfoTip,
+		"username_label":                                wc.UsernameLabel,
+		"widget_generation":                             wc.WidgetGeneration,
+	}
+	if wc.ShowPasswordVisibilityToggle != nil {
+		m["show_password_visibility_toggle"] = *wc.ShowPasswordVisibilityToggle
+	}
+	if wc.ShowUserIdentifier != nil {
+		m["show_user_identifier"] = *wc.ShowUserIdentifier
+	}
+	return []interface{}{m}
+}
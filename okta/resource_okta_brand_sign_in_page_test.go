@@ -0,0 +1,132 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceOktaBrandSignInPage_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(brandSignInPage)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", brandSignInPage)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// deleting the page resets it to the default page
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "brand_id"),
+					resource.TestCheckResourceAttr(resourceName, "preview", "false"),
+					resource.TestCheckResourceAttr(resourceName, "widget_version", "*"),
+					resource.TestCheckResourceAttr(resourceName, "page_content", ""),
+					resource.TestCheckResourceAttr(resourceName, "widget_customizations.0.sign_in_label", "Sign In"),
+					resource.TestCheckResourceAttr(resourceName, "widget_customizations.0.help_url", "https://example.com/help"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "widget_version", "7"),
+					resource.TestCheckResourceAttr(resourceName, "widget_customizations.0.sign_in_label", "Log In"),
+					resource.TestCheckResourceAttr(resourceName, "widget_customizations.0.show_password_visibility_toggle", "true"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.0.mode", "enforced"),
+					resource.TestCheckResourceAttr(resourceName, "content_security_policy_setting.0.src_list.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "page_content"),
+				),
+			},
+			{
+				// removing the page content resets the page to the default content
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "page_content", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceOktaBrandSignInPage_preview(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(brandSignInPage)
+	config := mgr.GetFixtures("preview.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", brandSignInPage)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// deleting the page resets it to the default page
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "preview", "true"),
+					resource.TestCheckResourceAttr(resourceName, "widget_customizations.0.sign_in_label", "Preview Sign In"),
+				),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%s/preview", s.RootModule().Resources[resourceName].Primary.ID), nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestImportBrandPage(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantPreview bool
+		wantErr     bool
+	}{
+		{id: "bnd123", wantPreview: false},
+		{id: "bnd123/preview", wantPreview: true},
+		{id: "bnd123/default", wantErr: true},
+		{id: "bnd123/preview/extra", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			d := resourceBrandErrorPage().TestResourceData()
+			d.SetId(tt.id)
+			_, err := importBrandPage(context.Background(), d, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error for ID %q", tt.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Id() != "bnd123" || d.Get("brand_id").(string) != "bnd123" {
+				t.Errorf("expected ID and brand_id to be 'bnd123', got %q and %q", d.Id(), d.Get("brand_id").(string))
+			}
+			if d.Get("preview").(bool) != tt.wantPreview {
+				t.Errorf("expected preview to be %t", tt.wantPreview)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceBrandSignOutPage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBrandSignOutPageCreate,
+		ReadContext:   resourceBrandSignOutPageRead,
+		UpdateContext: resourceBrandSignOutPageUpdate,
+		DeleteContext: resourceBrandSignOutPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importBrandSignOutPage,
+		},
+		Description: "Manages where users are redirected to after signing out, deleting the resource resets the setting to the Okta default sign-out page.",
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Brand ID",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.SignOutPageExternallyHosted, sdk.SignOutPageOktaDefault}),
+				Description:      "Whether users are redirected to an externally hosted page or to the Okta default sign-out page. Valid values: `EXTERNALLY_HOSTED`, `OKTA_DEFAULT`",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL of the externally hosted sign-out page, required when type is `EXTERNALLY_HOSTED`",
+			},
+		},
+	}
+}
+
+func importBrandSignOutPage(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("brand_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceBrandSignOutPageCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandSignOutPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("brand_id").(string))
+	return resourceBrandSignOutPageRead(ctx, d, m)
+}
+
+func resourceBrandSignOutPageRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	page, resp, err := getSupplementFromMetadata(m).GetSignOutPageSettings(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get brand sign-out page settings: %v", err)
+	}
+	if page == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("type", page.Type)
+	_ = d.Set("url", page.Url)
+	return nil
+}
+
+func resourceBrandSignOutPageUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := replaceBrandSignOutPage(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceBrandSignOutPageRead(ctx, d, m)
+}
+
+func resourceBrandSignOutPageDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, resp, err := getSupplementFromMetadata(m).ReplaceSignOutPageSettings(ctx, d.Id(), sdk.HostedPage{Type: sdk.SignOutPageOktaDefault})
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to reset brand sign-out page settings: %v", err)
+	}
+	return nil
+}
+
+func replaceBrandSignOutPage(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	brandID := d.Get("brand_id").(string)
+	page := sdk.HostedPage{
+		Type: d.Get("type").(string),
+		Url:  d.Get("url").(string),
+	}
+	if page.Type == sdk.SignOutPageExternallyHosted && page.Url == "" {
+		return fmt.Errorf("'url' is required when 'type' is '%s'", sdk.SignOutPageExternallyHosted)
+	}
+	_, _, err := getSupplementFromMetadata(m).ReplaceSignOutPageSettings(ctx, brandID, page)
+	if err != nil {
+		return fmt.Errorf("failed to replace brand sign-out page settings: %v", err)
+	}
+	return nil
+}
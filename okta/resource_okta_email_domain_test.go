@@ -0,0 +1,52 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailDomain_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailDomain)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", emailDomain)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailDomain, doesEmailDomainExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesEmailDomainExist),
+					resource.TestCheckResourceAttrSet(resourceName, "brand_id"),
+					resource.TestCheckResourceAttr(resourceName, "domain", fmt.Sprintf("testAcc-%d.example.com", ri)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Acc"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "no-reply"),
+					resource.TestCheckResourceAttr(resourceName, "validation_status", "NOT_STARTED"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.0.fqdn"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.0.value"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesEmailDomainExist),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Test Acc Updated"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "notifications"),
+				),
+			},
+		},
+	})
+}
+
+func doesEmailDomainExist(id string) (bool, error) {
+	_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEmailDomain(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
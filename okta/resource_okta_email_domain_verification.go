@@ -0,0 +1,74 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceEmailDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailDomainVerificationCreate,
+		ReadContext:   resourceEmailDomainVerificationRead,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Verifies the DNS records of an email domain, polling until the email domain is verified.",
+		Schema: map[string]*schema.Schema{
+			"email_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email domain ID",
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+func resourceEmailDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = d.Timeout(schema.TimeoutCreate)
+	bOff.InitialInterval = time.Second * 5
+	err := backoff.Retry(func() error {
+		emailDomain, _, err := getSupplementFromMetadata(m).VerifyEmailDomain(ctx, d.Get("email_domain_id").(string))
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to verify email domain: %v", err))
+		}
+		switch emailDomain.ValidationStatus {
+		case sdk.EmailDomainStatusVerified:
+			return nil
+		case sdk.EmailDomainStatusNotStarted, sdk.EmailDomainStatusInProgress, sdk.EmailDomainStatusPolling:
+			return fmt.Errorf("failed to verify email domain after several attempts, current validation status: %s", emailDomain.ValidationStatus)
+		default:
+			return backoff.Permanent(fmt.Errorf("failed to verify email domain, validation status: %s", emailDomain.ValidationStatus))
+		}
+	}, backoff.WithContext(bOff, ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("email_domain_id").(string))
+	return nil
+}
+
+func resourceEmailDomainVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	emailDomain, resp, err := getSupplementFromMetadata(m).GetEmailDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get email domain: %v", err)
+	}
+	// an email domain that is gone or no longer verified has to be verified again
+	if emailDomain == nil || emailDomain.ValidationStatus != sdk.EmailDomainStatusVerified {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("email_domain_id", d.Id())
+	return nil
+}
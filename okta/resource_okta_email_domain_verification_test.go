@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailDomainVerification_crud(t *testing.T) {
+	t.Skip("the DNS records of the email domain have to be created before it can be verified, which requires a domain managed by the test org")
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailDomainVerification)
+	config := mgr.ConfigReplace(`
+data "okta_brands" "test" {
+}
+
+resource "okta_email_domain" "test" {
+  brand_id     = tolist(data.okta_brands.test.brands)[0].id
+  domain       = "testAcc-replace_with_uuid.example.com"
+  display_name = "Test Acc"
+  user_name    = "no-reply"
+}
+
+resource "okta_email_domain_verification" "test" {
+  email_domain_id = okta_email_domain.test.id
+}`, ri)
+	resourceName := fmt.Sprintf("%s.test", emailDomainVerification)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailDomain, doesEmailDomainExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "email_domain_id", fmt.Sprintf("%s.test", emailDomain), "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceEventHook() *schema.Resource {
@@ -18,6 +19,7 @@ func resourceEventHook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateHookOAuth,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -35,8 +37,9 @@ func resourceEventHook() *schema.Resource {
 				Elem:     headerSchema,
 			},
 			"auth": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"oauth"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -82,7 +85,7 @@ func resourceEventHook() *schema.Resource {
 					var errs diag.Diagnostics
 					m := i.(map[string]interface{})
 					if t, ok := m["type"]; ok {
-						dErr := elemInSlice([]string{"HTTP"})(t, cty.GetAttrPath("type"))
+						dErr := elemInSlice([]string{sdk.HookChannelTypeHTTP, sdk.HookChannelTypeOAuth})(t, cty.GetAttrPath("type"))
 						if dErr != nil {
 							errs = append(errs, dErr...)
 						}
@@ -98,6 +101,7 @@ func resourceEventHook() *schema.Resource {
 					return errs
 				},
 			},
+			"oauth": hookOAuthSchema,
 		},
 	}
 }
@@ -105,7 +109,7 @@ func resourceEventHook() *schema.Resource {
 func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.CreateEventHook(ctx, *hook)
+	newHook, _, err := getSupplementFromMetadata(m).CreateEventHook(ctx, *hook)
 	if err != nil {
 		return diag.Errorf("failed to create event hook: %v", err)
 	}
@@ -118,7 +122,7 @@ func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(m).EventHook.GetEventHook(ctx, d.Id())
+	hook, resp, err := getSupplementFromMetadata(m).GetEventHook(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get event hook: %v", err)
 	}
@@ -133,6 +137,7 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 		"channel": flattenEventHookChannel(hook.Channel),
 		"headers": flattenEventHookHeaders(hook.Channel),
 		"auth":    flattenEventHookAuth(d, hook.Channel),
+		"oauth":   flattenHookOAuth(d, hook.Channel.Type, hook.Channel.Config.HookChannelConfigOAuth),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set event hook properties: %v", err)
@@ -143,7 +148,7 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 func resourceEventHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
 	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.UpdateEventHook(ctx, d.Id(), *hook)
+	newHook, _, err := getSupplementFromMetadata(m).UpdateEventHook(ctx, d.Id(), *hook)
 	if err != nil {
 		return diag.Errorf("failed to update auth event hook: %v", err)
 	}
@@ -168,21 +173,23 @@ func resourceEventHookDelete(ctx context.Context, d *schema.ResourceData, m inte
 	return nil
 }
 
-func buildEventHook(d *schema.ResourceData) *okta.EventHook {
+func buildEventHook(d *schema.ResourceData) *sdk.EventHook {
 	eventSet := d.Get("events").(*schema.Set).List()
 	events := make([]string, len(eventSet))
 	for i, v := range eventSet {
 		events[i] = v.(string)
 	}
-	return &okta.EventHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Events:  &okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
+	return &sdk.EventHook{
+		EventHook: okta.EventHook{
+			Name:   d.Get("name").(string),
+			Status: d.Get("status").(string),
+			Events: &okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
+		},
 		Channel: buildEventChannel(d),
 	}
 }
 
-func buildEventChannel(d *schema.ResourceData) *okta.EventHookChannel {
+func buildEventChannel(d *schema.ResourceData) *sdk.EventHookChannel {
 	var headerList []*okta.EventHookChannelConfigHeader
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
@@ -208,20 +215,32 @@ func buildEventChannel(d *schema.ResourceData) *okta.EventHookChannel {
 	rawChannel := d.Get("channel").(map[string]interface{})
 	_, ok := rawChannel["type"]
 	if !ok {
-		rawChannel["type"] = "HTTP"
+		rawChannel["type"] = sdk.HookChannelTypeHTTP
 	}
-	return &okta.EventHookChannel{
-		Config: &okta.EventHookChannelConfig{
+	config := &sdk.EventHookChannelConfig{
+		EventHookChannelConfig: okta.EventHookChannelConfig{
 			Uri:        rawChannel["uri"].(string),
 			AuthScheme: auth,
 			Headers:    headerList,
 		},
-		Type:    rawChannel["type"].(string),
-		Version: rawChannel["version"].(string),
+	}
+	if oauth := buildHookOAuth(d); oauth != nil {
+		rawChannel["type"] = sdk.HookChannelTypeOAuth
+		config.HookChannelConfigOAuth = *oauth
+	} else {
+		// the type of the state is kept when it is left out of the HCL
+		rawChannel["type"] = sdk.HookChannelTypeHTTP
+	}
+	return &sdk.EventHookChannel{
+		EventHookChannel: okta.EventHookChannel{
+			Type:    rawChannel["type"].(string),
+			Version: rawChannel["version"].(string),
+		},
+		Config: config,
 	}
 }
 
-func flattenEventHookAuth(d *schema.ResourceData, c *okta.EventHookChannel) map[string]interface{} {
+func flattenEventHookAuth(d *schema.ResourceData, c *sdk.EventHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
 		auth = map[string]interface{}{
@@ -233,7 +252,7 @@ func flattenEventHookAuth(d *schema.ResourceData, c *okta.EventHookChannel) map[
 	return auth
 }
 
-func flattenEventHookChannel(c *okta.EventHookChannel) map[string]interface{} {
+func flattenEventHookChannel(c *sdk.EventHookChannel) map[string]interface{} {
 	return map[string]interface{}{
 		"type":    c.Type,
 		"version": c.Version,
@@ -241,7 +260,7 @@ func flattenEventHookChannel(c *okta.EventHookChannel) map[string]interface{} {
 	}
 }
 
-func flattenEventHookHeaders(c *okta.EventHookChannel) *schema.Set {
+func flattenEventHookHeaders(c *sdk.EventHookChannel) *schema.Set {
 	headers := make([]interface{}, len(c.Config.Headers))
 	for i, header := range c.Config.Headers {
 		headers[i] = map[string]interface{}{
@@ -113,6 +113,44 @@ func TestAccOktaEventHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaEventHook_oauth(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "okta_event_hook.test"
+	mgr := newFixtureManager(eventHook)
+	config := mgr.GetFixtures("oauth.tf", ri, t)
+	removed := mgr.GetFixtures("oauth_removed.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(eventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.auth_type", "client_secret_post"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.token_url", "https://example.com/oauth2/token"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.client_id", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.client_secret", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.scope", "hooks"),
+				),
+			},
+			{
+				Config: removed,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "auth.type", "HEADER"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func eventHookExists(id string) (bool, error) {
 	eh, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).EventHook.GetEventHook(context.Background(), id)
 	if err := suppressErrorOn404(resp, err); err != nil {
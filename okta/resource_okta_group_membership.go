@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
@@ -49,21 +48,15 @@ func resourceGroupMembershipCreate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.Errorf("failed to add user to group: %v", err)
 	}
-	bOff := backoff.NewExponentialBackOff()
-	bOff.MaxElapsedTime = time.Second * 10
-	bOff.InitialInterval = time.Second
-	err = backoff.Retry(func() error {
+	err = waitForConsistency(ctx, 10*time.Second, func() (bool, error) {
 		inGroup, err := checkIfUserInGroup(ctx, client, groupId, userId)
 		if err != nil {
-			return backoff.Permanent(fmt.Errorf("failed to find user (%s) in group (%s) after addition with error: %v", userId, groupId, err))
+			return false, fmt.Errorf("failed to find user (%s) in group (%s) after addition with error: %v", userId, groupId, err)
 		}
-		if inGroup {
-			return nil
-		}
-		return fmt.Errorf("failed to find user (%s) in group (%s) after multiple tries", userId, groupId)
-	}, bOff)
+		return inGroup, nil
+	})
 	if err != nil {
-		return diag.FromErr(err)
+		return diag.Errorf("failed to find user (%s) in group (%s): %v", userId, groupId, err)
 	}
 	d.SetId(fmt.Sprintf("%s+%s", groupId, userId))
 	return nil
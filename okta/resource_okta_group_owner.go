@@ -0,0 +1,88 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceGroupOwner() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupOwnerCreate,
+		ReadContext:   resourceGroupOwnerRead,
+		DeleteContext: resourceGroupOwnerDelete,
+		Importer:      createNestedResourceImporter([]string{"group_id", "id"}),
+		Description:   "Resource to manage an owner of a group.",
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the user or the group that owns the group",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          sdk.GroupOwnerTypeUser,
+				ValidateDiagFunc: elemInSlice([]string{sdk.GroupOwnerTypeUser, sdk.GroupOwnerTypeGroup}),
+				Description:      "Type of the owner: USER or GROUP",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the owner",
+			},
+		},
+	}
+}
+
+func resourceGroupOwnerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owner, _, err := getSupplementFromMetadata(m).AssignGroupOwner(ctx, d.Get("group_id").(string), sdk.GroupOwner{
+		Id:   d.Get("owner_id").(string),
+		Type: d.Get("type").(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to assign group owner: %v", err)
+	}
+	d.SetId(owner.Id)
+	return resourceGroupOwnerRead(ctx, d, m)
+}
+
+func resourceGroupOwnerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owners, resp, err := getSupplementFromMetadata(m).ListGroupOwners(ctx, d.Get("group_id").(string), nil)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to list group owners: %v", err)
+	}
+	var owner *sdk.GroupOwner
+	for _, o := range owners {
+		if o.Id == d.Id() {
+			owner = o
+			break
+		}
+	}
+	if owner == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("owner_id", owner.Id)
+	_ = d.Set("type", owner.Type)
+	_ = d.Set("display_name", owner.DisplayName)
+	return nil
+}
+
+func resourceGroupOwnerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteGroupOwner(ctx, d.Get("group_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete group owner: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaGroupOwner_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupOwner)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", groupOwner)
+	groupResourceName := fmt.Sprintf("%s.test_group", groupOwner)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkGroupOwnerDestroy(groupOwner),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "owner_id", "okta_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "USER"),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+					resource.TestCheckResourceAttrPair(groupResourceName, "owner_id", "okta_group.owners", "id"),
+					resource.TestCheckResourceAttr(groupResourceName, "type", "GROUP"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+// checkGroupOwnerDestroy checks that the owners managed by the resources of
+// the given type are no longer assigned to their groups.
+func checkGroupOwnerDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			groupID := rs.Primary.Attributes["group_id"]
+			owners, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).ListGroupOwners(context.Background(), groupID, nil)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return err
+			}
+			for _, owner := range owners {
+				if owner.Id == rs.Primary.ID || owner.Id == rs.Primary.Attributes["owner_id"] {
+					return fmt.Errorf("owner %s of group %s still exists", owner.Id, groupID)
+				}
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceGroupOwners() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupOwnersCreate,
+		ReadContext:   resourceGroupOwnersRead,
+		UpdateContext: resourceGroupOwnersUpdate,
+		DeleteContext: resourceGroupOwnersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("group_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Resource to authoritatively manage the owners of a group. Owners that are not listed are removed.",
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the group",
+			},
+			"owner": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Owners of the group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the user or the group that owns the group",
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          sdk.GroupOwnerTypeUser,
+							ValidateDiagFunc: elemInSlice([]string{sdk.GroupOwnerTypeUser, sdk.GroupOwnerTypeGroup}),
+							Description:      "Type of the owner: USER or GROUP",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGroupOwnersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	groupID := d.Get("group_id").(string)
+	owners, _, err := getSupplementFromMetadata(m).ListGroupOwners(ctx, groupID, nil)
+	if err != nil {
+		return diag.Errorf("failed to list group owners: %v", err)
+	}
+	toAdd, toRemove := splitGroupOwners(buildGroupOwners(d.Get("owner").(*schema.Set)), owners)
+	if err := syncGroupOwners(ctx, m, groupID, toAdd, toRemove); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(groupID)
+	return resourceGroupOwnersRead(ctx, d, m)
+}
+
+func resourceGroupOwnersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owners, resp, err := getSupplementFromMetadata(m).ListGroupOwners(ctx, d.Id(), nil)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to list group owners: %v", err)
+	}
+	if is404(resp) {
+		d.SetId("")
+		return nil
+	}
+	arr := make([]interface{}, len(owners))
+	for i := range owners {
+		arr[i] = map[string]interface{}{
+			"id":   owners[i].Id,
+			"type": owners[i].Type,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"owner": arr})
+	if err != nil {
+		return diag.Errorf("failed to set group owners: %v", err)
+	}
+	return nil
+}
+
+func resourceGroupOwnersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	oldOwners, newOwners := d.GetChange("owner")
+	current := buildGroupOwners(oldOwners.(*schema.Set))
+	toAdd, toRemove := splitGroupOwners(buildGroupOwners(newOwners.(*schema.Set)), current)
+	if err := syncGroupOwners(ctx, m, d.Id(), toAdd, toRemove); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceGroupOwnersRead(ctx, d, m)
+}
+
+func resourceGroupOwnersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owners := buildGroupOwners(d.Get("owner").(*schema.Set))
+	if err := syncGroupOwners(ctx, m, d.Id(), nil, owners); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func buildGroupOwners(set *schema.Set) []*sdk.GroupOwner {
+	owners := make([]*sdk.GroupOwner, set.Len())
+	for i, v := range set.List() {
+		owner := v.(map[string]interface{})
+		owners[i] = &sdk.GroupOwner{
+			Id:   owner["id"].(string),
+			Type: owner["type"].(string),
+		}
+	}
+	return owners
+}
+
+// splitGroupOwners returns the desired owners that are not assigned yet and
+// the assigned owners that are not desired. Owners changing type are in both.
+func splitGroupOwners(desired, current []*sdk.GroupOwner) (toAdd, toRemove []*sdk.GroupOwner) {
+	key := func(o *sdk.GroupOwner) string { return o.Type + "/" + o.Id }
+	currentKeys := make(map[string]bool, len(current))
+	for _, o := range current {
+		currentKeys[key(o)] = true
+	}
+	desiredKeys := make(map[string]bool, len(desired))
+	for _, o := range desired {
+		desiredKeys[key(o)] = true
+		if !currentKeys[key(o)] {
+			toAdd = append(toAdd, o)
+		}
+	}
+	for _, o := range current {
+		if !desiredKeys[key(o)] {
+			toRemove = append(toRemove, o)
+		}
+	}
+	return
+}
+
+func syncGroupOwners(ctx context.Context, m interface{}, groupID string, toAdd, toRemove []*sdk.GroupOwner) error {
+	client := getSupplementFromMetadata(m)
+	for _, owner := range toRemove {
+		resp, err := client.DeleteGroupOwner(ctx, groupID, owner.Id)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("failed to delete group owner '%s': %v", owner.Id, err)
+		}
+	}
+	for _, owner := range toAdd {
+		_, _, err := client.AssignGroupOwner(ctx, groupID, sdk.GroupOwner{Id: owner.Id, Type: owner.Type})
+		if err != nil {
+			return fmt.Errorf("failed to assign group owner '%s': %v", owner.Id, err)
+		}
+	}
+	return nil
+}
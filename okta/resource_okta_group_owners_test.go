@@ -0,0 +1,60 @@
+package okta
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaGroupOwners_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(groupOwners)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", groupOwners)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      checkGroupOwnerDestroy(groupOwners),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "owner.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "owner.*", map[string]string{"type": "USER"}),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "owner.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "owner.*", map[string]string{"type": "GROUP"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSplitGroupOwners(t *testing.T) {
+	user1 := &sdk.GroupOwner{Id: "00u1", Type: sdk.GroupOwnerTypeUser}
+	user2 := &sdk.GroupOwner{Id: "00u2", Type: sdk.GroupOwnerTypeUser}
+	group1 := &sdk.GroupOwner{Id: "00g1", Type: sdk.GroupOwnerTypeGroup}
+	toAdd, toRemove := splitGroupOwners([]*sdk.GroupOwner{user1, group1}, []*sdk.GroupOwner{user1, user2})
+	if !reflect.DeepEqual(toAdd, []*sdk.GroupOwner{group1}) {
+		t.Errorf("expected %v to be added, got %v", group1, toAdd)
+	}
+	if !reflect.DeepEqual(toRemove, []*sdk.GroupOwner{user2}) {
+		t.Errorf("expected %v to be removed, got %v", user2, toRemove)
+	}
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -56,6 +57,7 @@ func resourceGroupRule() *schema.Resource {
 				Description: "The list of user IDs that would be excluded when rules are processed",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"max_wait_seconds": maxWaitSchema("Maximum number of seconds to wait after the rule is activated or changed until each of the assigned groups has members. Best effort, groups which already have members don't wait for the rule. Rules can match no users, so this is disabled by default."),
 		},
 		CustomizeDiff: customdiff.ForceNewIf("status", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 			g, _, _ := getOktaClientFromMetadata(meta).Group.GetGroupRule(ctx, d.Id(), nil)
@@ -79,6 +81,7 @@ func resourceGroupRuleCreate(ctx context.Context, d *schema.ResourceData, m inte
 	if err := handleGroupRuleLifecycle(ctx, d, m); err != nil {
 		return diag.Errorf("failed to change group rule status: %v", err)
 	}
+	waitForGroupRuleAssignments(ctx, d, m)
 	return resourceGroupRuleRead(ctx, d, m)
 }
 
@@ -146,6 +149,9 @@ func resourceGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			}
 		}
 	}
+	if d.HasChange("status") || hasGroupRuleChange(d) {
+		waitForGroupRuleAssignments(ctx, d, m)
+	}
 	return resourceGroupRuleRead(ctx, d, m)
 }
 
@@ -209,3 +215,31 @@ func handleGroupRuleLifecycle(ctx context.Context, d *schema.ResourceData, m int
 	_, err := client.Group.DeactivateGroupRule(ctx, d.Id())
 	return err
 }
+
+// waitForGroupRuleAssignments polls the assigned groups of an active rule
+// until each of them has members, since rules are applied asynchronously. It's
+// a best effort: the API can't tell which users match the expression of the
+// rule, so a group which already has members passes at once. A timeout is only
+// logged, as the rule might not match any user.
+func waitForGroupRuleAssignments(ctx context.Context, d *schema.ResourceData, m interface{}) {
+	if d.Get("status").(string) != statusActive {
+		return
+	}
+	client := getOktaClientFromMetadata(m)
+	groupIDs := convertInterfaceToStringSet(d.Get("group_assignments"))
+	err := waitForConsistency(ctx, getMaxWait(d, 0), func() (bool, error) {
+		for _, groupID := range groupIDs {
+			users, _, err := client.Group.ListGroupUsers(ctx, groupID, &query.Params{Limit: 1})
+			if err != nil {
+				return false, fmt.Errorf("failed to list group users: %v", err)
+			}
+			if len(users) == 0 {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	if err != nil {
+		logger(m).Warn("group rule has not assigned users to its groups yet", "id", d.Id(), "error", err)
+	}
+}
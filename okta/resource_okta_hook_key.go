@@ -0,0 +1,117 @@
+package okta
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceHookKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHookKeyCreate,
+		ReadContext:   resourceHookKeyRead,
+		UpdateContext: resourceHookKeyUpdate,
+		DeleteContext: resourceHookKeyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages a key pair generated by Okta to sign the client assertions of hooks using private_key_jwt.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the key",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Key ID of the public key, the 'kid' of the JWK",
+			},
+			"is_used": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is used by a hook",
+			},
+			"public_key_jwk": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Public key in JWK format, to be registered with the authorization server of the hook",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the key was created",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the key was last updated",
+			},
+		},
+	}
+}
+
+func resourceHookKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, _, err := getSupplementFromMetadata(m).CreateHookKey(ctx, sdk.HookKey{Name: d.Get("name").(string)})
+	if err != nil {
+		return diag.Errorf("failed to create hook key: %v", err)
+	}
+	d.SetId(key.Id)
+	return resourceHookKeyRead(ctx, d, m)
+}
+
+func resourceHookKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getSupplementFromMetadata(m).GetHookKey(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get hook key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", key.Name)
+	_ = d.Set("key_id", key.KeyId)
+	if key.IsUsed != nil {
+		_ = d.Set("is_used", *key.IsUsed)
+	}
+	if key.Created != nil {
+		_ = d.Set("created", key.Created.Format(time.RFC3339))
+	}
+	if key.LastUpdated != nil {
+		_ = d.Set("last_updated", key.LastUpdated.Format(time.RFC3339))
+	}
+	jwk, _, err := getSupplementFromMetadata(m).GetHookKeyPublicKey(ctx, key.KeyId)
+	if err != nil {
+		return diag.Errorf("failed to get public key of hook key: %v", err)
+	}
+	_ = d.Set("public_key_jwk", map[string]interface{}{
+		"alg": jwk.Alg,
+		"e":   jwk.E,
+		"kid": jwk.Kid,
+		"kty": jwk.Kty,
+		"n":   jwk.N,
+		"use": jwk.Use,
+	})
+	return nil
+}
+
+func resourceHookKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateHookKey(ctx, d.Id(), sdk.HookKey{Name: d.Get("name").(string)})
+	if err != nil {
+		return diag.Errorf("failed to update hook key: %v", err)
+	}
+	return resourceHookKeyRead(ctx, d, m)
+}
+
+// resourceHookKeyDelete keys used by a hook can not be deleted
+func resourceHookKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteHookKey(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete hook key: %v", err)
+	}
+	return nil
+}
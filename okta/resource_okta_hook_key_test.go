@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaHookKey_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(hookKey)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", hookKey)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(hookKey, doesHookKeyExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesHookKeyExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "is_used", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttr(resourceName, "public_key_jwk.kty", "RSA"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_key_jwk.kid", resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "public_key_jwk.n"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesHookKeyExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesHookKeyExist(id string) (bool, error) {
+	_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetHookKey(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The identity provider keeps signing with the key when the resource is destroyed.
+func resourceIdpActiveSigningKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdpActiveSigningKeyCreate,
+		ReadContext:   resourceIdpActiveSigningKeyRead,
+		UpdateContext: resourceIdpActiveSigningKeyUpdate,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the identity provider",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the key Okta signs the requests to the identity provider with",
+			},
+		},
+	}
+}
+
+func resourceIdpActiveSigningKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	idpID := d.Get("idp_id").(string)
+	_, err := getSupplementFromMetadata(m).SetIdpSigningKeyID(ctx, idpID, d.Get("key_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to set identity provider signing key: %v", err)
+	}
+	d.SetId(idpID)
+	return resourceIdpActiveSigningKeyRead(ctx, d, m)
+}
+
+func resourceIdpActiveSigningKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	kid, resp, err := getSupplementFromMetadata(m).GetIdpSigningKeyID(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get identity provider signing key: %v", err)
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("idp_id", d.Id())
+	_ = d.Set("key_id", kid)
+	return nil
+}
+
+func resourceIdpActiveSigningKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, err := getSupplementFromMetadata(m).SetIdpSigningKeyID(ctx, d.Id(), d.Get("key_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to update identity provider signing key: %v", err)
+	}
+	return resourceIdpActiveSigningKeyRead(ctx, d, m)
+}
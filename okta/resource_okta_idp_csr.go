@@ -0,0 +1,67 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIdpCsr() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdpCsrCreate,
+		ReadContext:   resourceIdpCsrRead,
+		DeleteContext: resourceIdpCsrDelete,
+		Importer:      createNestedResourceImporter([]string{"idp_id", "id"}),
+		Schema: buildSchema(csrSchema, map[string]*schema.Schema{
+			"idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the identity provider",
+			},
+		}),
+	}
+}
+
+func resourceIdpCsrCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	csr, _, err := getOktaClientFromMetadata(m).IdentityProvider.GenerateCsrForIdentityProvider(ctx, d.Get("idp_id").(string), buildCsrMetadata(d))
+	if err != nil {
+		return diag.Errorf("failed to generate identity provider CSR: %v", err)
+	}
+	d.SetId(csr.Id)
+	return resourceIdpCsrRead(ctx, d, m)
+}
+
+func resourceIdpCsrRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	idpID := d.Get("idp_id").(string)
+	csr, resp, err := client.IdentityProvider.GetCsrForIdentityProvider(ctx, idpID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get identity provider CSR: %v", err)
+	}
+	if csr == nil {
+		// Same as for apps, the published CSR becomes a signing key with the
+		// same ID.
+		key, resp, err := client.IdentityProvider.GetIdentityProviderSigningKey(ctx, idpID, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to get identity provider signing key: %v", err)
+		}
+		if key == nil {
+			d.SetId("")
+		}
+		return nil
+	}
+	if err := setCsr(d, csr); err != nil {
+		return diag.Errorf("failed to set identity provider CSR properties: %v", err)
+	}
+	return nil
+}
+
+func resourceIdpCsrDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getOktaClientFromMetadata(m).IdentityProvider.RevokeCsrForIdentityProvider(ctx, d.Get("idp_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to revoke identity provider CSR: %v", err)
+	}
+	return nil
+}
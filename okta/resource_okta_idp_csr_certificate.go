@@ -0,0 +1,64 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Publishing the certificate turns the CSR into a signing key of the identity
+// provider, destroying the resource only removes it from the state.
+func resourceIdpCsrCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdpCsrCertificateCreate,
+		ReadContext:   resourceIdpCsrCertificateRead,
+		DeleteContext: resourceFuncNoOp,
+		Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+			"idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the identity provider",
+			},
+			"csr_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the CSR the certificate was signed for",
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: stringIsPEMCertificate,
+				Description:      "PEM encoded certificate signed by the CA for the CSR",
+			},
+		}),
+	}
+}
+
+func resourceIdpCsrCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, _, err := getSupplementFromMetadata(m).PublishIdpCsr(ctx, d.Get("idp_id").(string), d.Get("csr_id").(string),
+		[]byte(d.Get("certificate").(string)))
+	if err != nil {
+		return diag.Errorf("failed to publish identity provider CSR certificate: %v", err)
+	}
+	d.SetId(key.Kid)
+	return resourceIdpCsrCertificateRead(ctx, d, m)
+}
+
+func resourceIdpCsrCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getOktaClientFromMetadata(m).IdentityProvider.GetIdentityProviderSigningKey(ctx, d.Get("idp_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get identity provider signing key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := setJSONWebKey(d, key); err != nil {
+		return diag.Errorf("failed to set identity provider signing key properties: %v", err)
+	}
+	return nil
+}
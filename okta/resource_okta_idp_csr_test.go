@@ -0,0 +1,40 @@
+package okta
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIdpCsr_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(idpCsr)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", idpCsr)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kty", "RSA"),
+					resource.TestCheckResourceAttrSet(resourceName, "csr"),
+					resource.TestMatchResourceAttr(resourceName, "csr_pem", regexp.MustCompile(`^-----BEGIN CERTIFICATE REQUEST-----`)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       idpKeyImportStateID(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"subject", "dns_names"},
+			},
+		},
+	})
+}
@@ -0,0 +1,61 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// Signing keys of an identity provider can't be deleted, destroying the
+// resource only removes it from the state.
+func resourceIdpKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIdpKeyCreate,
+		ReadContext:   resourceIdpKeyRead,
+		DeleteContext: resourceFuncNoOp,
+		Importer:      createNestedResourceImporter([]string{"idp_id", "id"}),
+		Schema: buildSchema(jsonWebKeySchema, map[string]*schema.Schema{
+			"idp_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the identity provider",
+			},
+			"years_valid": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          2,
+				ValidateDiagFunc: intBetween(2, 10),
+				Description:      "Number of years the certificate of the key is valid",
+			},
+		}),
+	}
+}
+
+func resourceIdpKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	qp := &query.Params{ValidityYears: int64(d.Get("years_valid").(int))}
+	key, _, err := getOktaClientFromMetadata(m).IdentityProvider.GenerateIdentityProviderSigningKey(ctx, d.Get("idp_id").(string), qp)
+	if err != nil {
+		return diag.Errorf("failed to generate identity provider signing key: %v", err)
+	}
+	d.SetId(key.Kid)
+	return resourceIdpKeyRead(ctx, d, m)
+}
+
+func resourceIdpKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	key, resp, err := getOktaClientFromMetadata(m).IdentityProvider.GetIdentityProviderSigningKey(ctx, d.Get("idp_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get identity provider signing key: %v", err)
+	}
+	if key == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := setJSONWebKey(d, key); err != nil {
+		return diag.Errorf("failed to set identity provider signing key properties: %v", err)
+	}
+	return nil
+}
@@ -52,6 +52,7 @@ func resourceIdpOidc() *schema.Resource {
 				Required:  true,
 				Sensitive: true,
 			},
+			"signing_kid": signingKidSchema,
 			"issuer_url": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -120,6 +121,7 @@ func resourceIdpRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	_ = d.Set("issuer_url", idp.Protocol.Issuer.Url)
 	_ = d.Set("client_secret", idp.Protocol.Credentials.Client.ClientSecret)
 	_ = d.Set("client_id", idp.Protocol.Credentials.Client.ClientId)
+	syncIdpSigningKid(d, idp.Protocol.Credentials)
 	syncEndpoint("authorization", idp.Protocol.Endpoints.Authorization, d)
 	syncEndpoint("token", idp.Protocol.Endpoints.Token, d)
 	syncEndpoint("user_info", idp.Protocol.Endpoints.UserInfo, d)
@@ -202,6 +204,7 @@ func buildIdPOidc(d *schema.ResourceData) (okta.IdentityProvider, error) {
 					ClientId:     d.Get("client_id").(string),
 					ClientSecret: d.Get("client_secret").(string),
 				},
+				Signing: buildIdpSigningCredentials(d),
 			},
 			Issuer: &okta.ProtocolEndpoint{
 				Url: d.Get("issuer_url").(string),
@@ -80,6 +80,7 @@ func resourceIdpSaml() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"signing_kid": signingKidSchema,
 			"max_clock_skew": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -136,6 +137,7 @@ func resourceIdpSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 	_ = d.Set("issuer", idp.Protocol.Credentials.Trust.Issuer)
 	_ = d.Set("audience", idp.Protocol.Credentials.Trust.Audience)
 	_ = d.Set("kid", idp.Protocol.Credentials.Trust.Kid)
+	syncIdpSigningKid(d, idp.Protocol.Credentials)
 	syncIdpSamlAlgo(d, idp.Protocol.Algorithms)
 	err = syncGroupActions(d, idp.Policy.Provisioning.Groups)
 	if err != nil {
@@ -228,6 +230,7 @@ func buildIdPSaml(d *schema.ResourceData) (okta.IdentityProvider, error) {
 					Kid:      d.Get("kid").(string),
 					Audience: d.Get("audience").(string),
 				},
+				Signing: buildIdpSigningCredentials(d),
 			},
 		},
 	}, nil
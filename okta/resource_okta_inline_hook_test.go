@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccOktaInlineHook_crud(t *testing.T) {
@@ -103,6 +105,107 @@ func TestAccOktaInlineHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaInlineHook_oauth(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "okta_inline_hook.test"
+	mgr := newFixtureManager(inlineHook)
+	config := mgr.GetFixtures("oauth.tf", ri, t)
+	removed := mgr.GetFixtures("oauth_removed.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(inlineHook, inlineHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, inlineHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "channel.uri", "https://example.com/test"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.auth_type", "private_key_jwt"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.token_url", "https://example.com/oauth2/token"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.client_id", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.scope", "hooks"),
+					resource.TestCheckResourceAttrPair(resourceName, "oauth.0.key_id", "okta_hook_key.test", "id"),
+				),
+			},
+			{
+				Config: removed,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, inlineHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "auth.type", "HEADER"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateHookOAuth(t *testing.T) {
+	channel := map[string]interface{}{"version": "1.0.0", "uri": "https://example.com/test", "method": "POST"}
+	tests := []struct {
+		name    string
+		oauth   map[string]interface{}
+		channel map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:  "client_secret_post",
+			oauth: map[string]interface{}{"auth_type": "client_secret_post", "token_url": "https://example.com/token", "client_id": "abc", "client_secret": "secret"},
+		},
+		{
+			name:  "private_key_jwt",
+			oauth: map[string]interface{}{"auth_type": "private_key_jwt", "token_url": "https://example.com/token", "client_id": "abc", "key_id": "HKY1"},
+		},
+		{
+			name:    "client_secret_post without secret",
+			oauth:   map[string]interface{}{"auth_type": "client_secret_post", "token_url": "https://example.com/token", "client_id": "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "private_key_jwt without key",
+			oauth:   map[string]interface{}{"auth_type": "private_key_jwt", "token_url": "https://example.com/token", "client_id": "abc"},
+			wantErr: true,
+		},
+		{
+			name:    "private_key_jwt with secret",
+			oauth:   map[string]interface{}{"auth_type": "private_key_jwt", "token_url": "https://example.com/token", "client_id": "abc", "key_id": "HKY1", "client_secret": "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "http channel",
+			oauth:   map[string]interface{}{"auth_type": "private_key_jwt", "token_url": "https://example.com/token", "client_id": "abc", "key_id": "HKY1"},
+			channel: map[string]interface{}{"type": "HTTP", "version": "1.0.0", "uri": "https://example.com/test", "method": "POST"},
+			wantErr: true,
+		},
+	}
+	r := resourceInlineHook()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := channel
+			if tt.channel != nil {
+				c = tt.channel
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":    "test",
+				"type":    "com.okta.oauth2.tokens.transform",
+				"version": "1.0.1",
+				"channel": c,
+				"oauth":   []interface{}{tt.oauth},
+			})
+			_, err := r.Diff(context.Background(), nil, config, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func inlineHookExists(id string) (bool, error) {
 	_, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).InlineHook.GetInlineHook(context.Background(), id)
 	if err := suppressErrorOn404(resp, err); err != nil {
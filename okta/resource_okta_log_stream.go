@@ -0,0 +1,228 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var (
+	logStreamAWSEventBridgeSchema = map[string]*schema.Schema{
+		"account_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "AWS account ID",
+		},
+		"event_source_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the AWS EventBridge partner event source",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "AWS region of the event source, e.g. 'us-east-1'",
+		},
+	}
+
+	logStreamSplunkCloudSchema = map[string]*schema.Schema{
+		"host": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Host of the Splunk Cloud instance, e.g. 'acme.splunkcloud.com'",
+		},
+		"edition": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: elemInSlice([]string{"aws", "aws_govcloud", "gcp"}),
+			Description:      "Edition of the Splunk Cloud instance: aws, aws_govcloud or gcp",
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "HTTP Event Collector token of the Splunk Cloud instance",
+		},
+	}
+)
+
+func resourceLogStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLogStreamCreate,
+		ReadContext:   resourceLogStreamRead,
+		UpdateContext: resourceLogStreamUpdate,
+		DeleteContext: resourceLogStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the log stream",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the log stream: aws_eventbridge or splunk_cloud_logstreaming",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Status of the log stream: ACTIVE or INACTIVE",
+			},
+			sdk.LogStreamTypeAWSEventBridge: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{sdk.LogStreamTypeAWSEventBridge, sdk.LogStreamTypeSplunkCloud},
+				Description:  "Settings of an AWS EventBridge log stream",
+				Elem:         &schema.Resource{Schema: logStreamAWSEventBridgeSchema},
+			},
+			sdk.LogStreamTypeSplunkCloud: {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{sdk.LogStreamTypeAWSEventBridge, sdk.LogStreamTypeSplunkCloud},
+				Description:  "Settings of a Splunk Cloud log stream",
+				Elem:         &schema.Resource{Schema: logStreamSplunkCloudSchema},
+			},
+		},
+	}
+}
+
+func resourceLogStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger(m).Info("creating log stream", "name", d.Get("name").(string))
+	stream, _, err := getSupplementFromMetadata(m).CreateLogStream(ctx, buildLogStream(d))
+	if err != nil {
+		return diag.Errorf("failed to create log stream: %v", err)
+	}
+	d.SetId(stream.Id)
+	err = setLogStreamStatus(ctx, d, m, stream.Status)
+	if err != nil {
+		return diag.Errorf("failed to set log stream status: %v", err)
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	stream, resp, err := getSupplementFromMetadata(m).GetLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get log stream: %v", err)
+	}
+	if stream == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", stream.Name)
+	_ = d.Set("type", stream.Type)
+	_ = d.Set("status", stream.Status)
+	// the token is not returned by the API
+	token := d.Get(sdk.LogStreamTypeSplunkCloud + ".0.token").(string)
+	err = setNonPrimitives(d, flattenLogStreamSettings(stream, token))
+	if err != nil {
+		return diag.Errorf("failed to set log stream settings: %v", err)
+	}
+	return nil
+}
+
+func resourceLogStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("name", sdk.LogStreamTypeSplunkCloud) {
+		_, _, err := getSupplementFromMetadata(m).UpdateLogStream(ctx, d.Id(), buildLogStream(d))
+		if err != nil {
+			return diag.Errorf("failed to update log stream: %v", err)
+		}
+	}
+	oldStatus, _ := d.GetChange("status")
+	err := setLogStreamStatus(ctx, d, m, oldStatus.(string))
+	if err != nil {
+		return diag.Errorf("failed to set log stream status: %v", err)
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getSupplementFromMetadata(m)
+	// active log streams can not be deleted
+	if d.Get("status").(string) == statusActive {
+		resp, err := client.DeactivateLogStream(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate log stream before removing: %v", err)
+		}
+	}
+	resp, err := client.DeleteLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete log stream: %v", err)
+	}
+	return nil
+}
+
+func buildLogStream(d *schema.ResourceData) sdk.LogStream {
+	stream := sdk.LogStream{
+		Name:     d.Get("name").(string),
+		Settings: &sdk.LogStreamSettings{},
+	}
+	if _, ok := d.GetOk(sdk.LogStreamTypeAWSEventBridge); ok {
+		stream.Type = sdk.LogStreamTypeAWSEventBridge
+		stream.Settings.AccountId = d.Get(sdk.LogStreamTypeAWSEventBridge + ".0.account_id").(string)
+		stream.Settings.EventSourceName = d.Get(sdk.LogStreamTypeAWSEventBridge + ".0.event_source_name").(string)
+		stream.Settings.Region = d.Get(sdk.LogStreamTypeAWSEventBridge + ".0.region").(string)
+	}
+	if _, ok := d.GetOk(sdk.LogStreamTypeSplunkCloud); ok {
+		stream.Type = sdk.LogStreamTypeSplunkCloud
+		stream.Settings.Host = d.Get(sdk.LogStreamTypeSplunkCloud + ".0.host").(string)
+		stream.Settings.Edition = d.Get(sdk.LogStreamTypeSplunkCloud + ".0.edition").(string)
+		stream.Settings.Token = d.Get(sdk.LogStreamTypeSplunkCloud + ".0.token").(string)
+	}
+	return stream
+}
+
+// flattenLogStreamSettings returns the settings block of the stream's type.
+// The token of Splunk Cloud streams is not returned by the API, so the given
+// one is used instead.
+func flattenLogStreamSettings(stream *sdk.LogStream, token string) map[string]interface{} {
+	if stream.Settings == nil {
+		return map[string]interface{}{}
+	}
+	switch stream.Type {
+	case sdk.LogStreamTypeAWSEventBridge:
+		return map[string]interface{}{
+			sdk.LogStreamTypeAWSEventBridge: []interface{}{map[string]interface{}{
+				"account_id":        stream.Settings.AccountId,
+				"event_source_name": stream.Settings.EventSourceName,
+				"region":            stream.Settings.Region,
+			}},
+		}
+	case sdk.LogStreamTypeSplunkCloud:
+		settings := map[string]interface{}{
+			"host":    stream.Settings.Host,
+			"edition": stream.Settings.Edition,
+		}
+		if token != "" {
+			settings["token"] = token
+		}
+		return map[string]interface{}{
+			sdk.LogStreamTypeSplunkCloud: []interface{}{settings},
+		}
+	}
+	return map[string]interface{}{}
+}
+
+func setLogStreamStatus(ctx context.Context, d *schema.ResourceData, m interface{}, status string) error {
+	desiredStatus := d.Get("status").(string)
+	if status == desiredStatus {
+		return nil
+	}
+	if desiredStatus == statusInactive {
+		return responseErr(getSupplementFromMetadata(m).DeactivateLogStream(ctx, d.Id()))
+	}
+	return responseErr(getSupplementFromMetadata(m).ActivateLogStream(ctx, d.Id()))
+}
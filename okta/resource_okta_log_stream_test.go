@@ -0,0 +1,102 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaLogStream_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "type", sdk.LogStreamTypeAWSEventBridge),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "aws_eventbridge.0.region", "us-east-1"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaLogStream_splunk(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("splunk.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", sdk.LogStreamTypeSplunkCloud),
+					resource.TestCheckResourceAttr(resourceName, "splunk_cloud_logstreaming.0.host", "acme.splunkcloud.com"),
+					resource.TestCheckResourceAttr(resourceName, "splunk_cloud_logstreaming.0.token", "11111111-1111-1111-1111-111111111111"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"splunk_cloud_logstreaming.0.token"},
+			},
+		},
+	})
+}
+
+func TestFlattenLogStreamSettings(t *testing.T) {
+	stream := &sdk.LogStream{
+		Type:     sdk.LogStreamTypeSplunkCloud,
+		Settings: &sdk.LogStreamSettings{Host: "acme.splunkcloud.com", Edition: "aws"},
+	}
+	expected := map[string]interface{}{
+		sdk.LogStreamTypeSplunkCloud: []interface{}{map[string]interface{}{
+			"host":    "acme.splunkcloud.com",
+			"edition": "aws",
+			"token":   "secret",
+		}},
+	}
+	if actual := flattenLogStreamSettings(stream, "secret"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+}
+
+func doesLogStreamExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetLogStream(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -0,0 +1,112 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceOrgFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrgFeatureCreate,
+		ReadContext:   resourceOrgFeatureRead,
+		UpdateContext: resourceOrgFeatureUpdate,
+		DeleteContext: resourceOrgFeatureDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Enables or disables a self-service feature of the org. Deleting the resource leaves the feature as it is.",
+		Schema: buildSchema(orgFeatureComputedSchema, map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the feature",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the feature is enabled",
+			},
+			"cascade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable the dependencies of the feature when enabling it, or disable its dependents when disabling it",
+			},
+		}),
+	}
+}
+
+func resourceOrgFeatureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feature, err := findOrgFeatureByName(ctx, m, d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(feature.Id)
+	if feature.Status != orgFeatureStatus(d) {
+		if diags := updateOrgFeatureLifecycle(ctx, d, m); diags != nil {
+			d.SetId("")
+			return diags
+		}
+	}
+	return resourceOrgFeatureRead(ctx, d, m)
+}
+
+func resourceOrgFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feature, resp, err := getOktaClientFromMetadata(m).Feature.GetFeature(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get org feature: %v", err)
+	}
+	if feature == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("enabled", feature.Status == "ENABLED")
+	if err := setOrgFeature(ctx, d, m, feature); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceOrgFeatureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		if diags := updateOrgFeatureLifecycle(ctx, d, m); diags != nil {
+			return diags
+		}
+	}
+	return resourceOrgFeatureRead(ctx, d, m)
+}
+
+// resourceOrgFeatureDelete only removes the resource from the state, other
+// features or resources of the org might depend on the feature.
+func resourceOrgFeatureDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func updateOrgFeatureLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	lifecycle := "disable"
+	if d.Get("enabled").(bool) {
+		lifecycle = "enable"
+	}
+	var qp *query.Params
+	if d.Get("cascade").(bool) {
+		qp = query.NewQueryParams(query.WithMode("force"))
+	}
+	_, _, err := getOktaClientFromMetadata(m).Feature.UpdateFeatureLifecycle(ctx, d.Id(), lifecycle, qp)
+	if err != nil {
+		return diag.Errorf("failed to %s org feature: %v", lifecycle, err)
+	}
+	return nil
+}
+
+func orgFeatureStatus(d *schema.ResourceData) string {
+	if d.Get("enabled").(bool) {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
@@ -0,0 +1,92 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyDeviceAssuranceAndroid() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceAndroidCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceAndroidRead,
+		UpdateContext: resourcePolicyDeviceAssuranceAndroidUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"os_version": deviceAssuranceOsVersionSchema,
+			"disk_encryption_type": deviceAssuranceIncludeSchema(
+				"Disk encryption types the device may use: FULL or USER",
+				[]string{sdk.DiskEncryptionTypeFull, sdk.DiskEncryptionTypeUser},
+			),
+			"screen_lock_type": deviceAssuranceIncludeSchema(
+				"Screen lock types the device may use: BIOMETRIC or PASSCODE",
+				[]string{sdk.ScreenLockTypeBiometric, sdk.ScreenLockTypePasscode},
+			),
+			"secure_hardware_present": deviceAssuranceSecureHardwareSchema,
+			"jailbreak":               deviceAssuranceJailbreakSchema,
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceAndroidCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	err := createDeviceAssurance(ctx, d, m, buildDeviceAssuranceAndroid(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceAndroidRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceAndroidRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	assurance, err := getDeviceAssurance(ctx, d, m, sdk.DeviceAssurancePlatformAndroid)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assurance == nil {
+		return nil
+	}
+	_ = d.Set("os_version", flattenDeviceAssuranceOsVersion(assurance.OsVersion))
+	_ = d.Set("secure_hardware_present", flattenOptionalBool(assurance.SecureHardwarePresent))
+	_ = d.Set("jailbreak", flattenOptionalBool(assurance.Jailbreak))
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type": flattenDiskEncryptionType(assurance.DiskEncryptionType),
+		"screen_lock_type":     flattenScreenLockType(assurance.ScreenLockType),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceAndroidUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	err := updateDeviceAssurance(ctx, d, m, buildDeviceAssuranceAndroid(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceAndroidRead(ctx, d, m)
+}
+
+func buildDeviceAssuranceAndroid(d *schema.ResourceData) sdk.DeviceAssurance {
+	return sdk.DeviceAssurance{
+		Name:                  d.Get("name").(string),
+		Platform:              sdk.DeviceAssurancePlatformAndroid,
+		OsVersion:             buildDeviceAssuranceOsVersion(d),
+		SecureHardwarePresent: configBoolPtr(d, "secure_hardware_present"),
+		Jailbreak:             configBoolPtr(d, "jailbreak"),
+		DiskEncryptionType:    buildDiskEncryptionType(d),
+		ScreenLockType:        buildScreenLockType(d),
+	}
+}
@@ -0,0 +1,86 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaPolicyDeviceAssuranceAndroid(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceAndroid)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceAndroid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceAndroid, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "platform", "ANDROID"),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					ensureDeviceAssuranceJailbreak(resourceName, boolPtr(false)),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "false"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					ensureDeviceAssuranceJailbreak(resourceName, nil),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesDeviceAssuranceExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetDeviceAssurance(context.Background(), id)
+	return doesResourceExist(response, err)
+}
+
+// ensureDeviceAssuranceJailbreak checks the jailbreak signal sent to the API,
+// as false and unset are the same in the state.
+func ensureDeviceAssuranceJailbreak(name string, expected *bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		assurance, _, err := getSupplementFromMetadata(testAccProvider.Meta()).GetDeviceAssurance(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get device assurance policy: %v", err)
+		}
+		switch {
+		case expected == nil && assurance.Jailbreak != nil:
+			return fmt.Errorf("expected jailbreak not to be checked, got %t", *assurance.Jailbreak)
+		case expected != nil && assurance.Jailbreak == nil:
+			return fmt.Errorf("expected jailbreak to be %t, got unset", *expected)
+		case expected != nil && *assurance.Jailbreak != *expected:
+			return fmt.Errorf("expected jailbreak to be %t, got %t", *expected, *assurance.Jailbreak)
+		}
+		return nil
+	}
+}
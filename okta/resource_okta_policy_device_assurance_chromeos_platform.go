@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// ChromeOS devices only report signals through Chrome Device Trust.
+func resourcePolicyDeviceAssuranceChromeOS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceChromeOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceChromeOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceChromeOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"chrome_device_trust": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Signals reported by the Chrome Device Trust connector",
+				Elem:        &schema.Resource{Schema: chromeDeviceTrustSchema},
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceChromeOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	err := createDeviceAssurance(ctx, d, m, buildDeviceAssuranceChromeOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceChromeOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceChromeOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	assurance, err := getDeviceAssurance(ctx, d, m, sdk.DeviceAssurancePlatformChromeOS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assurance == nil {
+		return nil
+	}
+	providers := assurance.ThirdPartySignalProviders
+	if providers == nil {
+		providers = &sdk.DeviceAssuranceThirdPartySignalProviders{}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"chrome_device_trust": flattenChromeDeviceTrust(providers.Dtc, false),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceChromeOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	err := updateDeviceAssurance(ctx, d, m, buildDeviceAssuranceChromeOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceChromeOSRead(ctx, d, m)
+}
+
+func buildDeviceAssuranceChromeOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	return sdk.DeviceAssurance{
+		Name:     d.Get("name").(string),
+		Platform: sdk.DeviceAssurancePlatformChromeOS,
+		ThirdPartySignalProviders: &sdk.DeviceAssuranceThirdPartySignalProviders{
+			Dtc: buildChromeDeviceTrust(d),
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceChromeOS(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceChromeOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceChromeOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceChromeOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "platform", "CHROMEOS"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.os_version", "108.0.5359.172"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.key_trust_level", "CHROME_BROWSER_HW_KEY"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.screen_lock_secured", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
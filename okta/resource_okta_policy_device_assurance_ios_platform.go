@@ -0,0 +1,83 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyDeviceAssuranceIOS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceIOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceIOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceIOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"os_version": deviceAssuranceOsVersionSchema,
+			"screen_lock_type": deviceAssuranceIncludeSchema(
+				"Screen lock types the device may use: BIOMETRIC or PASSCODE",
+				[]string{sdk.ScreenLockTypeBiometric, sdk.ScreenLockTypePasscode},
+			),
+			"jailbreak": deviceAssuranceJailbreakSchema,
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceIOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	err := createDeviceAssurance(ctx, d, m, buildDeviceAssuranceIOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceIOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceIOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	assurance, err := getDeviceAssurance(ctx, d, m, sdk.DeviceAssurancePlatformIOS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assurance == nil {
+		return nil
+	}
+	_ = d.Set("os_version", flattenDeviceAssuranceOsVersion(assurance.OsVersion))
+	_ = d.Set("jailbreak", flattenOptionalBool(assurance.Jailbreak))
+	err = setNonPrimitives(d, map[string]interface{}{
+		"screen_lock_type": flattenScreenLockType(assurance.ScreenLockType),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceIOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	err := updateDeviceAssurance(ctx, d, m, buildDeviceAssuranceIOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceIOSRead(ctx, d, m)
+}
+
+func buildDeviceAssuranceIOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	return sdk.DeviceAssurance{
+		Name:           d.Get("name").(string),
+		Platform:       sdk.DeviceAssurancePlatformIOS,
+		OsVersion:      buildDeviceAssuranceOsVersion(d),
+		Jailbreak:      configBoolPtr(d, "jailbreak"),
+		ScreenLockType: buildScreenLockType(d),
+	}
+}
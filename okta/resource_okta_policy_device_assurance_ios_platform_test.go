@@ -0,0 +1,41 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceIOS(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceIOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceIOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceIOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "platform", "IOS"),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "16.1"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					ensureDeviceAssuranceJailbreak(resourceName, boolPtr(false)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
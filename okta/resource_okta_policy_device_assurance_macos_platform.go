@@ -0,0 +1,99 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyDeviceAssuranceMacOS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceMacOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceMacOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceMacOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"os_version": deviceAssuranceOsVersionSchema,
+			"disk_encryption_type": deviceAssuranceIncludeSchema(
+				"Disk encryption types the device may use: ALL_INTERNAL_VOLUMES",
+				[]string{sdk.DiskEncryptionTypeAllInternalVolumes},
+			),
+			"screen_lock_type": deviceAssuranceIncludeSchema(
+				"Screen lock types the device may use: BIOMETRIC or PASSCODE",
+				[]string{sdk.ScreenLockTypeBiometric, sdk.ScreenLockTypePasscode},
+			),
+			"secure_hardware_present": deviceAssuranceSecureHardwareSchema,
+			"chrome_device_trust":     chromeDeviceTrustBlockSchema(nil),
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceMacOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	err := createDeviceAssurance(ctx, d, m, buildDeviceAssuranceMacOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceMacOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceMacOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	assurance, err := getDeviceAssurance(ctx, d, m, sdk.DeviceAssurancePlatformMacOS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assurance == nil {
+		return nil
+	}
+	_ = d.Set("os_version", flattenDeviceAssuranceOsVersion(assurance.OsVersion))
+	_ = d.Set("secure_hardware_present", flattenOptionalBool(assurance.SecureHardwarePresent))
+	providers := assurance.ThirdPartySignalProviders
+	if providers == nil {
+		providers = &sdk.DeviceAssuranceThirdPartySignalProviders{}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type": flattenDiskEncryptionType(assurance.DiskEncryptionType),
+		"screen_lock_type":     flattenScreenLockType(assurance.ScreenLockType),
+		"chrome_device_trust":  flattenChromeDeviceTrust(providers.Dtc, false),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceMacOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	err := updateDeviceAssurance(ctx, d, m, buildDeviceAssuranceMacOS(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceMacOSRead(ctx, d, m)
+}
+
+func buildDeviceAssuranceMacOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	assurance := sdk.DeviceAssurance{
+		Name:                  d.Get("name").(string),
+		Platform:              sdk.DeviceAssurancePlatformMacOS,
+		OsVersion:             buildDeviceAssuranceOsVersion(d),
+		SecureHardwarePresent: configBoolPtr(d, "secure_hardware_present"),
+		DiskEncryptionType:    buildDiskEncryptionType(d),
+		ScreenLockType:        buildScreenLockType(d),
+	}
+	if dtc := buildChromeDeviceTrust(d); dtc != nil {
+		assurance.ThirdPartySignalProviders = &sdk.DeviceAssuranceThirdPartySignalProviders{Dtc: dtc}
+	}
+	return assurance
+}
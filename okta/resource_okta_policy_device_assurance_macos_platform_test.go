@@ -0,0 +1,42 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceMacOS(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceMacOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceMacOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceMacOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "platform", "MACOS"),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.browser_version", "106.0.5249.61"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.safe_browsing_protection_level", "ENHANCED_PROTECTION"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
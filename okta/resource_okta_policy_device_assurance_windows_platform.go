@@ -0,0 +1,196 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var (
+	windowsChromeDeviceTrustSchema = map[string]*schema.Schema{
+		"crowd_strike_agent_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID of the CrowdStrike agent that must be installed on the device",
+		},
+		"crowd_strike_customer_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "CrowdStrike customer ID the agent must be registered with",
+		},
+		"secure_boot_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether Secure Boot must be enabled",
+		},
+		"third_party_blocking_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether Chrome must block third-party software injection",
+		},
+		"windows_machine_domain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Windows domain the device must be joined to",
+		},
+		"windows_user_domain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Windows domain the user must belong to",
+		},
+	}
+
+	windowsSecurityCenterSchema = map[string]*schema.Schema{
+		"anti_virus": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the antivirus protection must be healthy",
+		},
+		"auto_update_settings": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the automatic update settings must be healthy",
+		},
+		"firewall": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the firewall protection must be healthy",
+		},
+		"internet_settings": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Whether the internet security settings must be healthy",
+		},
+	}
+)
+
+func resourcePolicyDeviceAssuranceWindows() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceWindowsCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceWindowsRead,
+		UpdateContext: resourcePolicyDeviceAssuranceWindowsUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"os_version": deviceAssuranceOsVersionSchema,
+			"disk_encryption_type": deviceAssuranceIncludeSchema(
+				"Disk encryption types the device may use: ALL_INTERNAL_VOLUMES",
+				[]string{sdk.DiskEncryptionTypeAllInternalVolumes},
+			),
+			"screen_lock_type": deviceAssuranceIncludeSchema(
+				"Screen lock types the device may use: BIOMETRIC or PASSCODE",
+				[]string{sdk.ScreenLockTypeBiometric, sdk.ScreenLockTypePasscode},
+			),
+			"secure_hardware_present": deviceAssuranceSecureHardwareSchema,
+			"chrome_device_trust":     chromeDeviceTrustBlockSchema(windowsChromeDeviceTrustSchema),
+			"windows_security_center": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Signals reported by the Windows Security Center",
+				Elem:        &schema.Resource{Schema: windowsSecurityCenterSchema},
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceWindowsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	err := createDeviceAssurance(ctx, d, m, buildDeviceAssuranceWindows(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceWindowsRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceWindowsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	assurance, err := getDeviceAssurance(ctx, d, m, sdk.DeviceAssurancePlatformWindows)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if assurance == nil {
+		return nil
+	}
+	_ = d.Set("os_version", flattenDeviceAssuranceOsVersion(assurance.OsVersion))
+	_ = d.Set("secure_hardware_present", flattenOptionalBool(assurance.SecureHardwarePresent))
+	providers := assurance.ThirdPartySignalProviders
+	if providers == nil {
+		providers = &sdk.DeviceAssuranceThirdPartySignalProviders{}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type":    flattenDiskEncryptionType(assurance.DiskEncryptionType),
+		"screen_lock_type":        flattenScreenLockType(assurance.ScreenLockType),
+		"chrome_device_trust":     flattenChromeDeviceTrust(providers.Dtc, true),
+		"windows_security_center": flattenWindowsSecurityCenter(providers.WindowsSecurityCenter),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceWindowsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	err := updateDeviceAssurance(ctx, d, m, buildDeviceAssuranceWindows(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourcePolicyDeviceAssuranceWindowsRead(ctx, d, m)
+}
+
+func buildDeviceAssuranceWindows(d *schema.ResourceData) sdk.DeviceAssurance {
+	assurance := sdk.DeviceAssurance{
+		Name:                  d.Get("name").(string),
+		Platform:              sdk.DeviceAssurancePlatformWindows,
+		OsVersion:             buildDeviceAssuranceOsVersion(d),
+		SecureHardwarePresent: configBoolPtr(d, "secure_hardware_present"),
+		DiskEncryptionType:    buildDiskEncryptionType(d),
+		ScreenLockType:        buildScreenLockType(d),
+	}
+	dtc := buildChromeDeviceTrust(d)
+	wsc := buildWindowsSecurityCenter(d)
+	if dtc != nil || wsc != nil {
+		assurance.ThirdPartySignalProviders = &sdk.DeviceAssuranceThirdPartySignalProviders{
+			Dtc:                   dtc,
+			WindowsSecurityCenter: wsc,
+		}
+	}
+	return assurance
+}
+
+func buildWindowsSecurityCenter(d *schema.ResourceData) *sdk.DeviceAssuranceWindowsSecurityCenter {
+	raw, ok := d.GetOk("windows_security_center")
+	if !ok || len(raw.([]interface{})) == 0 || raw.([]interface{})[0] == nil {
+		return nil
+	}
+	v := raw.([]interface{})[0].(map[string]interface{})
+	return &sdk.DeviceAssuranceWindowsSecurityCenter{
+		AntiVirus:          optionalBoolPtr(v["anti_virus"]),
+		AutoUpdateSettings: optionalBoolPtr(v["auto_update_settings"]),
+		Firewall:           optionalBoolPtr(v["firewall"]),
+		InternetSettings:   optionalBoolPtr(v["internet_settings"]),
+	}
+}
+
+func flattenWindowsSecurityCenter(wsc *sdk.DeviceAssuranceWindowsSecurityCenter) []interface{} {
+	if wsc == nil {
+		return []interface{}{}
+	}
+	return []interface{}{map[string]interface{}{
+		"anti_virus":           flattenOptionalBool(wsc.AntiVirus),
+		"auto_update_settings": flattenOptionalBool(wsc.AutoUpdateSettings),
+		"firewall":             flattenOptionalBool(wsc.Firewall),
+		"internet_settings":    flattenOptionalBool(wsc.InternetSettings),
+	}}
+}
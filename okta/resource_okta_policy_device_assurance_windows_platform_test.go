@@ -0,0 +1,42 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceWindows(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceWindows)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceWindows)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceWindows, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "platform", "WINDOWS"),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "10.0.19045"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.secure_boot_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.windows_user_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "windows_security_center.0.anti_virus", "true"),
+					resource.TestCheckResourceAttr(resourceName, "windows_security_center.0.auto_update_settings", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,6 +17,7 @@ func resourcePolicyMfaRule() *schema.Resource {
 		UpdateContext: resourcePolicyMfaRuleUpdate,
 		DeleteContext: resourcePolicyMfaRuleDelete,
 		Importer:      createPolicyRuleImporter(),
+		CustomizeDiff: validateMfaPolicyRule,
 		Schema: buildRuleSchema(map[string]*schema.Schema{
 			"enroll": {
 				Type:             schema.TypeString,
@@ -92,6 +94,33 @@ func resourcePolicyMfaRuleDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// validateMfaPolicyRule is run at plan time and mirrors the constraints the
+// API enforces on MFA policy rules.
+func validateMfaPolicyRule(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"app_include", "app_exclude"} {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		for _, item := range d.Get(key).(*schema.Set).List() {
+			value, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			switch getMapString(value, "type") {
+			case "APP":
+				if getMapString(value, "id") == "" {
+					return fmt.Errorf("'id' is required for every '%s' entry of type 'APP'", key)
+				}
+			case "APP_TYPE":
+				if getMapString(value, "name") == "" {
+					return fmt.Errorf("'name' is required for every '%s' entry of type 'APP_TYPE'", key)
+				}
+			}
+		}
+	}
+	return validatePolicyRuleNetwork(d)
+}
+
 // build password policy rule from schema data
 func buildMfaPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 	rule := sdk.MfaPolicyRule()
@@ -11,6 +11,10 @@ import (
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+// maxSessionMinutes is the longest session idle time and lifetime (90 days)
+// accepted by the API.
+const maxSessionMinutes = 129600
+
 func resourcePolicySignOnRule() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePolicySignOnRuleCreate,
@@ -18,6 +22,7 @@ func resourcePolicySignOnRule() *schema.Resource {
 		UpdateContext: resourcePolicySignOnRuleUpdate,
 		DeleteContext: resourcePolicySignOnRuleDelete,
 		Importer:      createPolicyRuleImporter(),
+		CustomizeDiff: validateSignOnPolicyRule,
 		Schema: buildRuleSchema(map[string]*schema.Schema{
 			"authtype": {
 				Type:             schema.TypeString,
@@ -59,7 +64,7 @@ func resourcePolicySignOnRule() *schema.Resource {
 			"session_idle": {
 				Type:        schema.TypeInt,
 				Optional:    true,
-				Description: "Max minutes a session can be idle.",
+				Description: "Max minutes a session can be idle. Can not be greater than session_lifetime.",
 				Default:     120,
 			},
 			"session_lifetime": {
@@ -148,12 +153,8 @@ func resourcePolicySignOnRule() *schema.Resource {
 }
 
 func resourcePolicySignOnRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := validateSignOnPolicyRule(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	template := buildSignOnPolicyRule(d)
-	err = createRule(ctx, d, m, template, policyRuleSignOn)
+	err := createRule(ctx, d, m, template, policyRuleSignOn)
 	if err != nil {
 		return diag.Errorf("failed to create sign-on policy rule: %v", err)
 	}
@@ -235,12 +236,8 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourcePolicySignOnRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := validateSignOnPolicyRule(d)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	template := buildSignOnPolicyRule(d)
-	err = updateRule(ctx, d, m, template)
+	err := updateRule(ctx, d, m, template)
 	if err != nil {
 		return diag.Errorf("failed to update sign-on policy rule: %v", err)
 	}
@@ -340,25 +337,43 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 	return template
 }
 
-func validateSignOnPolicyRule(d *schema.ResourceData) error {
-	_, ok := d.GetOk("factor_sequence")
-	isChallenge := d.Get("access").(string) == "CHALLENGE"
-	if (!ok && isChallenge) || (ok && !isChallenge) {
-		return errors.New("'factor_sequence' can only be set when access is 'CHALLENGE' and vice versa")
+// validateSignOnPolicyRule is run at plan time and mirrors the constraints
+// the API enforces on sign-on policy rules.
+func validateSignOnPolicyRule(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if newValuesKnown(d, "access", "factor_sequence") {
+		_, ok := d.GetOk("factor_sequence")
+		isChallenge := d.Get("access").(string) == "CHALLENGE"
+		if (!ok && isChallenge) || (ok && !isChallenge) {
+			return errors.New("'factor_sequence' can only be set when access is 'CHALLENGE' and vice versa")
+		}
 	}
-	prompt, ok := d.GetOk("mfa_prompt")
-	if !ok {
-		return nil
+	prompt := d.Get("mfa_prompt").(string)
+	promptKnown := d.NewValueKnown("mfa_prompt")
+	if promptKnown && d.NewValueKnown("mfa_required") && prompt != "" && !d.Get("mfa_required").(bool) {
+		return errors.New("'mfa_prompt' can only be set when 'mfa_required' is true")
 	}
-	if prompt.(string) != "DEVICE" {
-		d, ok := d.GetOk("mfa_remember_device")
-		if ok && d.(bool) {
-			return errors.New("'mfa_remember_device' can only be set when mfa_prompt='DEVICE'")
-		}
+	if promptKnown && d.NewValueKnown("mfa_remember_device") && d.Get("mfa_remember_device").(bool) && prompt != "" && prompt != "DEVICE" {
+		return errors.New("'mfa_remember_device' can only be set when mfa_prompt='DEVICE'")
 	}
-	ip, ok := d.GetOk("identity_provider")
-	if ok && ip == "SPECIFIC_IDP" && len(convertInterfaceToStringArrNullable(d.Get("identity_provider_ids"))) < 1 {
-		return errors.New("'identity_provider_ids' should have at least one element when 'identity_provider' is 'SPECIFIC_IDP'")
+	if promptKnown && d.NewValueKnown("mfa_lifetime") && d.Get("mfa_lifetime").(int) > 0 && prompt != "SESSION" {
+		return errors.New("'mfa_lifetime' can only be set when 'mfa_required' is true and 'mfa_prompt' is 'SESSION'")
 	}
-	return nil
+	idle := d.Get("session_idle").(int)
+	lifetime := d.Get("session_lifetime").(int)
+	if d.NewValueKnown("session_idle") && (idle < 1 || idle > maxSessionMinutes) {
+		return fmt.Errorf("'session_idle' must be between 1 and %d minutes, got: %d", maxSessionMinutes, idle)
+	}
+	if d.NewValueKnown("session_lifetime") && (lifetime < 0 || lifetime > maxSessionMinutes) {
+		return fmt.Errorf("'session_lifetime' must be between 0 (disabled) and %d minutes, got: %d", maxSessionMinutes, lifetime)
+	}
+	if newValuesKnown(d, "session_idle", "session_lifetime") && lifetime > 0 && idle > lifetime {
+		return fmt.Errorf("'session_idle' (%d) can not be greater than 'session_lifetime' (%d)", idle, lifetime)
+	}
+	if newValuesKnown(d, "identity_provider", "identity_provider_ids") {
+		ip := d.Get("identity_provider").(string)
+		if ip == "SPECIFIC_IDP" && len(convertInterfaceToStringArrNullable(d.Get("identity_provider_ids"))) < 1 {
+			return errors.New("'identity_provider_ids' should have at least one element when 'identity_provider' is 'SPECIFIC_IDP'")
+		}
+	}
+	return validatePolicyRuleNetwork(d)
 }
@@ -26,6 +26,68 @@ func TestAccOktaPolicyRuleSignon_defaultErrors(t *testing.T) {
 	})
 }
 
+func TestAccOktaPolicyRuleSignon_planValidation(t *testing.T) {
+	name := buildResourceName(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createRuleCheckDestroy(policyRuleSignOn),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  policy_id        = "garbageID"
+  name             = "%s"
+  session_idle     = 240
+  session_lifetime = 120
+}`, policyRuleSignOn, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'session_idle' \(240\) can not be greater than 'session_lifetime' \(120\)`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  policy_id        = "garbageID"
+  name             = "%s"
+  network_includes = ["garbageZoneID"]
+}`, policyRuleSignOn, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'network_includes' and 'network_excludes' can only be set when 'network_connection' is 'ZONE'`),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "%s" "test" {
+  policy_id    = "garbageID"
+  name         = "%s"
+  mfa_required = false
+  mfa_prompt   = "SESSION"
+}`, policyRuleSignOn, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'mfa_prompt' can only be set when 'mfa_required' is true`),
+			},
+			{
+				// a value only known after apply doesn't skip the unrelated checks
+				Config: fmt.Sprintf(`
+resource "okta_group" "test" {
+  name = "%s"
+}
+
+resource "%s" "test" {
+  policy_id        = "garbageID"
+  name             = "%s"
+  mfa_required     = false
+  mfa_prompt       = "SESSION"
+  session_lifetime = length(okta_group.test.id)
+}`, name, policyRuleSignOn, name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`'mfa_prompt' can only be set when 'mfa_required' is true`),
+			},
+		},
+	})
+}
+
 func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(policyRuleSignOn)
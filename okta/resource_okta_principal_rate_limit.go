@@ -0,0 +1,145 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// Principal rate limits can't be deleted, the resource takes over the settings
+// of the principal if they already exist and leaves them in place on destroy.
+func resourcePrincipalRateLimit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrincipalRateLimitCreate,
+		ReadContext:   resourcePrincipalRateLimitRead,
+		UpdateContext: resourcePrincipalRateLimitUpdate,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"principal_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API token or of the OAuth 2.0 client.",
+			},
+			"principal_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.PrincipalTypeSSWSToken, sdk.PrincipalTypeOAuthClient}),
+				Description:      "Type of the principal, 'SSWS_TOKEN' or 'OAUTH_CLIENT'.",
+			},
+			"default_percentage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(0, 100),
+				Description:      "Percentage of the rate limits of the org available to the principal.",
+			},
+			"default_concurrency_percentage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(0, 100),
+				Description:      "Percentage of the concurrent requests limit of the org available to the principal.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the user who created the principal rate limit.",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date of the last update of the principal rate limit.",
+			},
+		},
+	}
+}
+
+func resourcePrincipalRateLimitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	existing, err := findPrincipalRateLimit(ctx, m, d.Get("principal_type").(string), d.Get("principal_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to create principal rate limit: %v", err)
+	}
+	var limit *sdk.PrincipalRateLimit
+	if existing != nil {
+		limit, _, err = getSupplementFromMetadata(m).UpdatePrincipalRateLimit(ctx, existing.Id, buildPrincipalRateLimit(d))
+	} else {
+		limit, _, err = getSupplementFromMetadata(m).CreatePrincipalRateLimit(ctx, buildPrincipalRateLimit(d))
+	}
+	if err != nil {
+		return diag.Errorf("failed to create principal rate limit: %v", err)
+	}
+	d.SetId(limit.Id)
+	return resourcePrincipalRateLimitRead(ctx, d, m)
+}
+
+func resourcePrincipalRateLimitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	limit, resp, err := getSupplementFromMetadata(m).GetPrincipalRateLimit(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get principal rate limit: %v", err)
+	}
+	if limit == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("principal_id", limit.PrincipalId)
+	_ = d.Set("principal_type", limit.PrincipalType)
+	if limit.DefaultPercentage != nil {
+		_ = d.Set("default_percentage", *limit.DefaultPercentage)
+	}
+	if limit.DefaultConcurrencyPercentage != nil {
+		_ = d.Set("default_concurrency_percentage", *limit.DefaultConcurrencyPercentage)
+	}
+	_ = d.Set("created_by", limit.CreatedBy)
+	_ = d.Set("last_updated", limit.LastUpdate)
+	return nil
+}
+
+func resourcePrincipalRateLimitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdatePrincipalRateLimit(ctx, d.Id(), buildPrincipalRateLimit(d))
+	if err != nil {
+		return diag.Errorf("failed to update principal rate limit: %v", err)
+	}
+	return resourcePrincipalRateLimitRead(ctx, d, m)
+}
+
+// findPrincipalRateLimit returns nil if the principal has no rate limit
+// settings yet.
+func findPrincipalRateLimit(ctx context.Context, m interface{}, principalType, principalID string) (*sdk.PrincipalRateLimit, error) {
+	qp := &query.Params{Filter: fmt.Sprintf("principalType eq \"%s\"", principalType)}
+	limits, _, err := getSupplementFromMetadata(m).ListPrincipalRateLimits(ctx, qp)
+	if err != nil {
+		return nil, err
+	}
+	for _, limit := range limits {
+		if limit.PrincipalId == principalID {
+			return limit, nil
+		}
+	}
+	return nil, nil
+}
+
+func buildPrincipalRateLimit(d *schema.ResourceData) sdk.PrincipalRateLimit {
+	limit := sdk.PrincipalRateLimit{
+		PrincipalId:   d.Get("principal_id").(string),
+		PrincipalType: d.Get("principal_type").(string),
+	}
+	// zero is a valid percentage, only send the percentages set in the HCL
+	if !d.GetRawConfig().GetAttr("default_percentage").IsNull() {
+		percentage := int64(d.Get("default_percentage").(int))
+		limit.DefaultPercentage = &percentage
+	}
+	if !d.GetRawConfig().GetAttr("default_concurrency_percentage").IsNull() {
+		percentage := int64(d.Get("default_concurrency_percentage").(int))
+		limit.DefaultConcurrencyPercentage = &percentage
+	}
+	return limit
+}
@@ -0,0 +1,145 @@
+package okta
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePushProviderAPNS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePushProviderAPNSCreate,
+		ReadContext:   resourcePushProviderAPNSRead,
+		UpdateContext: resourcePushProviderAPNSUpdate,
+		DeleteContext: resourcePushProviderAPNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages an Apple Push Notification service (APNs) push provider used by custom app authenticators.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the push provider",
+			},
+			"team_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Team ID used to sign the APNs push notifications",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key ID of the APNs token signing key",
+			},
+			"token_signing_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local path to the .p8 token signing key. Changes to the content of the file are detected",
+				StateFunc:   localFileStateFunc,
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "File name of the token signing key, defaults to the base name of 'token_signing_key'",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the push provider was last updated",
+			},
+		},
+	}
+}
+
+func resourcePushProviderAPNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderAPNS)
+	}
+	body, err := buildPushProviderAPNS(d)
+	if err != nil {
+		return diag.Errorf("failed to create APNs push provider: %v", err)
+	}
+	provider, _, err := getSupplementFromMetadata(m).CreatePushProvider(ctx, *body)
+	if err != nil {
+		return diag.Errorf("failed to create APNs push provider: %v", err)
+	}
+	d.SetId(provider.Id)
+	return resourcePushProviderAPNSRead(ctx, d, m)
+}
+
+func resourcePushProviderAPNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderAPNS)
+	}
+	provider, err := getPushProvider(ctx, d, m, sdk.PushProviderTypeAPNS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if provider == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", provider.Name)
+	_ = d.Set("last_updated", provider.LastUpdatedDate)
+	if provider.Configuration != nil {
+		_ = d.Set("team_id", provider.Configuration.TeamId)
+		_ = d.Set("key_id", provider.Configuration.KeyId)
+		_ = d.Set("file_name", provider.Configuration.FileName)
+	}
+	return nil
+}
+
+func resourcePushProviderAPNSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderAPNS)
+	}
+	body, err := buildPushProviderAPNS(d)
+	if err != nil {
+		return diag.Errorf("failed to update APNs push provider: %v", err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdatePushProvider(ctx, d.Id(), *body)
+	if err != nil {
+		return diag.Errorf("failed to update APNs push provider: %v", err)
+	}
+	return resourcePushProviderAPNSRead(ctx, d, m)
+}
+
+func resourcePushProviderAPNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderAPNS)
+	}
+	if err := deletePushProvider(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// buildPushProviderAPNS reads the token signing key from the local file, the
+// API never returns it.
+func buildPushProviderAPNS(d *schema.ResourceData) (*sdk.PushProvider, error) {
+	keyPath := d.Get("token_signing_key").(string)
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	fileName := d.Get("file_name").(string)
+	if fileName == "" {
+		fileName = filepath.Base(keyPath)
+	}
+	return &sdk.PushProvider{
+		Name:         d.Get("name").(string),
+		ProviderType: sdk.PushProviderTypeAPNS,
+		Configuration: &sdk.PushProviderConfiguration{
+			TeamId:          d.Get("team_id").(string),
+			KeyId:           d.Get("key_id").(string),
+			TokenSigningKey: string(key),
+			FileName:        fileName,
+		},
+	}, nil
+}
@@ -0,0 +1,86 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func TestAccOktaPushProviderAPNS_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(pushProviderAPNS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", pushProviderAPNS)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(pushProviderAPNS, doesPushProviderExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesPushProviderExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "ABCDE12345"),
+					resource.TestCheckResourceAttr(resourceName, "key_id", "ABC123DEFG"),
+					resource.TestCheckResourceAttr(resourceName, "token_signing_key", computeFileHash("../examples/okta_push_provider_apns/test_token_signing_key.p8")),
+					resource.TestCheckResourceAttr(resourceName, "file_name", "test_token_signing_key.p8"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesPushProviderExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "key_id", "DEF456GHIJ"),
+					resource.TestCheckResourceAttr(resourceName, "token_signing_key", computeFileHash("../examples/okta_push_provider_apns/test_token_signing_key_rotated.p8")),
+					resource.TestCheckResourceAttr(resourceName, "file_name", "AuthKey_DEF456GHIJ.p8"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token_signing_key"},
+			},
+		},
+	})
+}
+
+func TestBuildPushProviderAPNS(t *testing.T) {
+	keyPath := "../examples/okta_push_provider_apns/test_token_signing_key.p8"
+	d := resourcePushProviderAPNS().TestResourceData()
+	_ = d.Set("name", "test")
+	_ = d.Set("team_id", "ABCDE12345")
+	_ = d.Set("key_id", "ABC123DEFG")
+	_ = d.Set("token_signing_key", keyPath)
+	provider, err := buildPushProviderAPNS(d)
+	if err != nil {
+		t.Fatalf("failed to build APNs push provider: %v", err)
+	}
+	if provider.ProviderType != sdk.PushProviderTypeAPNS {
+		t.Errorf("expected provider type %q, got %q", sdk.PushProviderTypeAPNS, provider.ProviderType)
+	}
+	if provider.Configuration.FileName != "test_token_signing_key.p8" {
+		t.Errorf("expected file name to default to the base name of the key, got %q", provider.Configuration.FileName)
+	}
+	if provider.Configuration.TokenSigningKey == "" || provider.Configuration.TokenSigningKey == keyPath {
+		t.Errorf("expected token signing key to be the content of %s", keyPath)
+	}
+
+	_ = d.Set("token_signing_key", "../examples/okta_push_provider_apns/missing.p8")
+	if _, err := buildPushProviderAPNS(d); err == nil {
+		t.Error("expected an error when the token signing key file doesn't exist")
+	}
+}
+
+func doesPushProviderExist(id string) (bool, error) {
+	_, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetPushProvider(context.Background(), id)
+	return doesResourceExist(resp, err)
+}
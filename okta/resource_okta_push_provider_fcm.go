@@ -0,0 +1,132 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePushProviderFCM() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePushProviderFCMCreate,
+		ReadContext:   resourcePushProviderFCMRead,
+		UpdateContext: resourcePushProviderFCMUpdate,
+		DeleteContext: resourcePushProviderFCMDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages a Firebase Cloud Messaging (FCM) push provider used by custom app authenticators.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the push provider",
+			},
+			"service_account_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				Description:      "Content of the JSON key file of the Firebase service account",
+				ValidateDiagFunc: stringIsJSON,
+				StateFunc:        normalizeDataJSON,
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "File name of the service account JSON key",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Firebase project of the service account",
+			},
+			"last_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the push provider was last updated",
+			},
+		},
+	}
+}
+
+func resourcePushProviderFCMCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderFCM)
+	}
+	body, err := buildPushProviderFCM(d)
+	if err != nil {
+		return diag.Errorf("failed to create FCM push provider: %v", err)
+	}
+	provider, _, err := getSupplementFromMetadata(m).CreatePushProvider(ctx, *body)
+	if err != nil {
+		return diag.Errorf("failed to create FCM push provider: %v", err)
+	}
+	d.SetId(provider.Id)
+	return resourcePushProviderFCMRead(ctx, d, m)
+}
+
+func resourcePushProviderFCMRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderFCM)
+	}
+	provider, err := getPushProvider(ctx, d, m, sdk.PushProviderTypeFCM)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if provider == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", provider.Name)
+	_ = d.Set("last_updated", provider.LastUpdatedDate)
+	if provider.Configuration != nil {
+		_ = d.Set("project_id", provider.Configuration.ProjectId)
+		_ = d.Set("file_name", provider.Configuration.FileName)
+	}
+	return nil
+}
+
+func resourcePushProviderFCMUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderFCM)
+	}
+	body, err := buildPushProviderFCM(d)
+	if err != nil {
+		return diag.Errorf("failed to update FCM push provider: %v", err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdatePushProvider(ctx, d.Id(), *body)
+	if err != nil {
+		return diag.Errorf("failed to update FCM push provider: %v", err)
+	}
+	return resourcePushProviderFCMRead(ctx, d, m)
+}
+
+func resourcePushProviderFCMDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(pushProviderFCM)
+	}
+	if err := deletePushProvider(ctx, d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func buildPushProviderFCM(d *schema.ResourceData) (*sdk.PushProvider, error) {
+	var serviceAccount map[string]interface{}
+	err := json.Unmarshal([]byte(d.Get("service_account_json").(string)), &serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.PushProvider{
+		Name:         d.Get("name").(string),
+		ProviderType: sdk.PushProviderTypeFCM,
+		Configuration: &sdk.PushProviderConfiguration{
+			ServiceAccountJson: serviceAccount,
+			FileName:           d.Get("file_name").(string),
+		},
+	}, nil
+}
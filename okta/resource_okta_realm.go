@@ -0,0 +1,108 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceRealm() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRealmCreate,
+		ReadContext:   resourceRealmRead,
+		UpdateContext: resourceRealmUpdate,
+		DeleteContext: resourceRealmDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the realm",
+			},
+			"realm_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          sdk.RealmTypeDefault,
+				ValidateDiagFunc: elemInSlice([]string{sdk.RealmTypeDefault, sdk.RealmTypePartner}),
+				Description:      "Type of the realm: DEFAULT or PARTNER",
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the realm is the default realm of the org",
+			},
+		},
+	}
+}
+
+func resourceRealmCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realm)
+	}
+	logger(m).Info("creating realm", "name", d.Get("name").(string))
+	created, _, err := getSupplementFromMetadata(m).CreateRealm(ctx, buildRealm(d))
+	if err != nil {
+		return diag.Errorf("failed to create realm: %v", err)
+	}
+	d.SetId(created.Id)
+	return resourceRealmRead(ctx, d, m)
+}
+
+func resourceRealmRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realm)
+	}
+	r, resp, err := getSupplementFromMetadata(m).GetRealm(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get realm: %v", err)
+	}
+	if r == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("is_default", r.IsDefault)
+	if r.Profile != nil {
+		_ = d.Set("name", r.Profile.Name)
+		_ = d.Set("realm_type", r.Profile.RealmType)
+	}
+	return nil
+}
+
+func resourceRealmUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realm)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateRealm(ctx, d.Id(), buildRealm(d))
+	if err != nil {
+		return diag.Errorf("failed to update realm: %v", err)
+	}
+	return resourceRealmRead(ctx, d, m)
+}
+
+func resourceRealmDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realm)
+	}
+	// the default realm of the org can not be deleted
+	if d.Get("is_default").(bool) {
+		return nil
+	}
+	resp, err := getSupplementFromMetadata(m).DeleteRealm(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete realm: %v", err)
+	}
+	return nil
+}
+
+func buildRealm(d *schema.ResourceData) sdk.Realm {
+	return sdk.Realm{
+		Profile: &sdk.RealmProfile{
+			Name:      d.Get("name").(string),
+			RealmType: d.Get("realm_type").(string),
+		},
+	}
+}
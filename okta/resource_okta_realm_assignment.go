@@ -0,0 +1,179 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceRealmAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRealmAssignmentCreate,
+		ReadContext:   resourceRealmAssignmentRead,
+		UpdateContext: resourceRealmAssignmentUpdate,
+		DeleteContext: resourceRealmAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Resource to manage a rule that assigns users to a realm. The rules are evaluated in order of priority.",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the realm assignment",
+			},
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the realm the matching users are assigned to",
+			},
+			"profile_source_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the profile source (an identity provider or a directory app) of the users",
+			},
+			"condition_expression": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Okta expression the users must match, e.g. 'user.profile.userType == \"contractor\"'",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Priority of the realm assignment, the lowest one is evaluated first. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
+			},
+			"status": statusSchema,
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the realm assignment is the catch-all assignment of the org",
+			},
+		},
+	}
+}
+
+func resourceRealmAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realmAssignment)
+	}
+	logger(m).Info("creating realm assignment", "name", d.Get("name").(string))
+	assignment := buildRealmAssignment(d)
+	created, _, err := getSupplementFromMetadata(m).CreateRealmAssignment(ctx, assignment)
+	if err != nil {
+		return diag.Errorf("failed to create realm assignment: %v", err)
+	}
+	d.SetId(created.Id)
+	// We want to put this under Terraform's control even if priority is invalid.
+	if err := validatePriority(assignment.Priority, created.Priority); err != nil {
+		return diag.FromErr(err)
+	}
+	err = setRealmAssignmentStatus(ctx, d, m, created.Status)
+	if err != nil {
+		return diag.Errorf("failed to set realm assignment status: %v", err)
+	}
+	return resourceRealmAssignmentRead(ctx, d, m)
+}
+
+func resourceRealmAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realmAssignment)
+	}
+	assignment, resp, err := getSupplementFromMetadata(m).GetRealmAssignment(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get realm assignment: %v", err)
+	}
+	if assignment == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", assignment.Name)
+	_ = d.Set("priority", assignment.Priority)
+	_ = d.Set("status", assignment.Status)
+	_ = d.Set("is_default", assignment.IsDefault)
+	if assignment.Conditions != nil {
+		_ = d.Set("profile_source_id", assignment.Conditions.ProfileSourceId)
+		if assignment.Conditions.Expression != nil {
+			_ = d.Set("condition_expression", assignment.Conditions.Expression.Value)
+		}
+	}
+	if assignment.Actions != nil && assignment.Actions.AssignUserToRealm != nil {
+		_ = d.Set("realm_id", assignment.Actions.AssignUserToRealm.RealmId)
+	}
+	return nil
+}
+
+func resourceRealmAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realmAssignment)
+	}
+	if d.HasChanges("name", "realm_id", "profile_source_id", "condition_expression", "priority") {
+		assignment := buildRealmAssignment(d)
+		updated, _, err := getSupplementFromMetadata(m).UpdateRealmAssignment(ctx, d.Id(), assignment)
+		if err != nil {
+			return diag.Errorf("failed to update realm assignment: %v", err)
+		}
+		if err := validatePriority(assignment.Priority, updated.Priority); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	oldStatus, _ := d.GetChange("status")
+	err := setRealmAssignmentStatus(ctx, d, m, oldStatus.(string))
+	if err != nil {
+		return diag.Errorf("failed to set realm assignment status: %v", err)
+	}
+	return resourceRealmAssignmentRead(ctx, d, m)
+}
+
+func resourceRealmAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(realmAssignment)
+	}
+	client := getSupplementFromMetadata(m)
+	// active realm assignments can not be deleted
+	if d.Get("status").(string) == statusActive {
+		resp, err := client.DeactivateRealmAssignment(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate realm assignment before removing: %v", err)
+		}
+	}
+	resp, err := client.DeleteRealmAssignment(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete realm assignment: %v", err)
+	}
+	return nil
+}
+
+func buildRealmAssignment(d *schema.ResourceData) sdk.RealmAssignment {
+	assignment := sdk.RealmAssignment{
+		Name: d.Get("name").(string),
+		Conditions: &sdk.RealmAssignmentConditions{
+			ProfileSourceId: d.Get("profile_source_id").(string),
+			Expression: &sdk.RealmAssignmentExpression{
+				Value: d.Get("condition_expression").(string),
+			},
+		},
+		Actions: &sdk.RealmAssignmentActions{
+			AssignUserToRealm: &sdk.RealmAssignmentAssignUserToRealm{
+				RealmId: d.Get("realm_id").(string),
+			},
+		},
+	}
+	if priority, ok := d.GetOk("priority"); ok {
+		assignment.Priority = int64(priority.(int))
+	}
+	return assignment
+}
+
+func setRealmAssignmentStatus(ctx context.Context, d *schema.ResourceData, m interface{}, status string) error {
+	desiredStatus := d.Get("status").(string)
+	if status == desiredStatus {
+		return nil
+	}
+	if desiredStatus == statusInactive {
+		return responseErr(getSupplementFromMetadata(m).DeactivateRealmAssignment(ctx, d.Id()))
+	}
+	return responseErr(getSupplementFromMetadata(m).ActivateRealmAssignment(ctx, d.Id()))
+}
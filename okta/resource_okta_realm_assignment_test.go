@@ -0,0 +1,76 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaRealmAssignment_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(realmAssignment)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", realmAssignment)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(realmAssignment, doesRealmAssignmentExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttrPair(resourceName, "realm_id", "okta_realm.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_source_id", "okta_idp_oidc.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "condition_expression", `user.profile.userType == "contractor"`),
+					resource.TestCheckResourceAttrSet(resourceName, "priority"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)+"_updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "condition_expression", `user.profile.userType == "vendor"`),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestBuildRealmAssignment(t *testing.T) {
+	d := resourceRealmAssignment().TestResourceData()
+	_ = d.Set("name", "contractors")
+	_ = d.Set("realm_id", "realmID")
+	_ = d.Set("profile_source_id", "idpID")
+	_ = d.Set("condition_expression", `user.profile.userType == "contractor"`)
+	got := buildRealmAssignment(d)
+	if got.Priority != 0 {
+		t.Errorf("expected the priority to be left to the API, got %d", got.Priority)
+	}
+	if got.Actions.AssignUserToRealm.RealmId != "realmID" || got.Conditions.ProfileSourceId != "idpID" {
+		t.Errorf("unexpected realm assignment %+v", got)
+	}
+	_ = d.Set("priority", 2)
+	if got := buildRealmAssignment(d); got.Priority != 2 {
+		t.Errorf("expected priority 2, got %d", got.Priority)
+	}
+}
+
+func doesRealmAssignmentExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetRealmAssignment(context.Background(), id)
+	return doesResourceExist(response, err)
+}
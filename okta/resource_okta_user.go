@@ -295,6 +295,7 @@ func resourceUser() *schema.Resource {
 				Description:  "If set to `true`, the user will have to change the password at the next login. This property will be used when user is being created and works only when `password` field is set",
 				RequiredWith: []string{"password"},
 			},
+			"max_wait_seconds": maxWaitSchema("Maximum number of seconds to wait after the user is created or its login is changed until it appears in user searches, e.g. of the `okta_users` data source. Set to `0` to not wait. Default is `30`."),
 			"password_inline_hook": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -456,6 +457,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		}
 	}
 
+	waitForUserSearch(ctx, d, m)
 	return resourceUserRead(ctx, d, m)
 }
 
@@ -661,6 +663,10 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 			return diag.Errorf("failed to change user's password recovery question: %v", err)
 		}
 	}
+
+	if d.HasChange("login") {
+		waitForUserSearch(ctx, d, m)
+	}
 	return resourceUserRead(ctx, d, m)
 }
 
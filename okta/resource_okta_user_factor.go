@@ -0,0 +1,289 @@
+package okta
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var userFactorTypes = []string{
+	sdk.UserFactorTypeCall,
+	sdk.UserFactorTypeEmail,
+	sdk.UserFactorTypeQuestion,
+	sdk.UserFactorTypeSms,
+	sdk.UserFactorTypeTotp,
+}
+
+func resourceUserFactor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserFactorCreate,
+		ReadContext:   resourceUserFactorRead,
+		UpdateContext: resourceUserFactorUpdate,
+		DeleteContext: resourceUserFactorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				// imported factors are assumed to be activated already, avoids a replacement
+				_ = d.Set("activate", true)
+				return createNestedResourceImporter([]string{"user_id", "id"}).StateContext(ctx, d, m)
+			},
+		},
+		CustomizeDiff: validateUserFactor,
+		Description:   "Resource to enroll a factor for a user. Deleting the resource resets the factor.",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of a Okta User",
+			},
+			"factor_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice(userFactorTypes),
+				Description:      "Type of the factor: call, email, question, sms or token:software:totp",
+			},
+			"provider_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "OKTA",
+				ValidateDiagFunc: elemInSlice([]string{"OKTA", "GOOGLE"}),
+				Description:      "Provider of the factor, GOOGLE is only supported by token:software:totp",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Email address, required by the email factor",
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Phone number in E.164 format, required by the sms and call factors",
+			},
+			"phone_extension": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Phone extension of the call factor",
+			},
+			"question": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Security question key, required by the question factor",
+			},
+			"answer": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateDiagFunc: stringLenBetween(4, 1000),
+				Description:      "Security question answer, required by the question factor",
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Activate the factor on enrollment, otherwise the user has to activate it",
+			},
+			"shared_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Shared secret of the token:software:totp factor, only known when the factor is enrolled",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User factor status",
+			},
+			"vendor_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Vendor name of the factor",
+			},
+		},
+	}
+}
+
+// userFactorProfileAttrs are the profile attributes each factor type requires
+var userFactorProfileAttrs = map[string][]string{
+	sdk.UserFactorTypeCall:     {"phone_number"},
+	sdk.UserFactorTypeEmail:    {"email"},
+	sdk.UserFactorTypeQuestion: {"question", "answer"},
+	sdk.UserFactorTypeSms:      {"phone_number"},
+	sdk.UserFactorTypeTotp:     {},
+}
+
+func validateUserFactor(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	factorType := d.Get("factor_type").(string)
+	required, ok := userFactorProfileAttrs[factorType]
+	if !ok {
+		return nil
+	}
+	for _, attr := range []string{"email", "phone_number", "phone_extension", "question", "answer"} {
+		isSet := d.Get(attr).(string) != "" || !d.NewValueKnown(attr)
+		isRequired := contains(required, attr)
+		if isRequired && !isSet {
+			return fmt.Errorf("'%s' is required by '%s' factors", attr, factorType)
+		}
+		allowed := isRequired || (attr == "phone_extension" && factorType == sdk.UserFactorTypeCall)
+		if !allowed && isSet {
+			return fmt.Errorf("'%s' can not be set for '%s' factors", attr, factorType)
+		}
+	}
+	if d.Get("provider_id").(string) == "GOOGLE" && factorType != sdk.UserFactorTypeTotp {
+		return fmt.Errorf("'GOOGLE' provider is only supported by '%s' factors", sdk.UserFactorTypeTotp)
+	}
+	return nil
+}
+
+func resourceUserFactorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	factorType := d.Get("factor_type").(string)
+	activate := d.Get("activate").(bool)
+	var qp *query.Params
+	// TOTP factors are activated with a passcode, security questions are always active
+	if activate && factorType != sdk.UserFactorTypeTotp && factorType != sdk.UserFactorTypeQuestion {
+		qp = query.NewQueryParams(query.WithActivate(true))
+	}
+	factor, _, err := getSupplementFromMetadata(m).EnrollUserFactor(ctx, userID, buildUserFactor(d), qp)
+	if err != nil {
+		return diag.Errorf("failed to enroll user factor: %v", err)
+	}
+	d.SetId(factor.Id)
+	if factorType == sdk.UserFactorTypeTotp {
+		if factor.Embedded == nil || factor.Embedded.Activation == nil {
+			return diag.Errorf("failed to enroll user factor: shared secret is missing from the enrollment response")
+		}
+		activation := factor.Embedded.Activation
+		_ = d.Set("shared_secret", activation.SharedSecret)
+		if activate {
+			passCode, err := totpPassCode(activation.SharedSecret, activation.TimeStep, activation.KeyLength, time.Now())
+			if err != nil {
+				return diag.Errorf("failed to compute passcode to activate user factor: %v", err)
+			}
+			_, _, err = getSupplementFromMetadata(m).ActivateUserFactor(ctx, userID, factor.Id, passCode)
+			if err != nil {
+				return diag.Errorf("failed to activate user factor: %v", err)
+			}
+		}
+	}
+	return resourceUserFactorRead(ctx, d, m)
+}
+
+func resourceUserFactorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	factor, resp, err := getSupplementFromMetadata(m).GetUserFactor(ctx, d.Get("user_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user factor: %v", err)
+	}
+	if factor == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("factor_type", factor.FactorType)
+	_ = d.Set("provider_id", factor.Provider)
+	_ = d.Set("status", factor.Status)
+	_ = d.Set("vendor_name", factor.VendorName)
+	profileAttrs := map[string]string{
+		"email":           "email",
+		"phone_number":    "phoneNumber",
+		"phone_extension": "phoneExtension",
+		"question":        "question",
+	}
+	for attr, key := range profileAttrs {
+		if v, ok := factor.Profile[key].(string); ok {
+			_ = d.Set(attr, v)
+		}
+	}
+	return nil
+}
+
+// resourceUserFactorUpdate only the security question and its answer can be
+// updated, all the other arguments force a new factor.
+func resourceUserFactorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	sq := &okta.SecurityQuestionUserFactor{
+		Profile: &okta.SecurityQuestionUserFactorProfile{
+			Answer:   d.Get("answer").(string),
+			Question: d.Get("question").(string),
+		},
+	}
+	_, err := getSupplementFromMetadata(m).UpdateUserFactor(ctx, d.Get("user_id").(string), d.Id(), sq)
+	if err != nil {
+		return diag.Errorf("failed to update user factor: %v", err)
+	}
+	return resourceUserFactorRead(ctx, d, m)
+}
+
+func resourceUserFactorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).ResetUserFactor(ctx, d.Get("user_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to reset user factor: %v", err)
+	}
+	return nil
+}
+
+func buildUserFactor(d *schema.ResourceData) sdk.UserFactor {
+	profile := map[string]interface{}{}
+	profileAttrs := map[string]string{
+		"email":           "email",
+		"phone_number":    "phoneNumber",
+		"phone_extension": "phoneExtension",
+		"question":        "question",
+		"answer":          "answer",
+	}
+	for attr, key := range profileAttrs {
+		if v := d.Get(attr).(string); v != "" {
+			profile[key] = v
+		}
+	}
+	factor := sdk.UserFactor{
+		FactorType: d.Get("factor_type").(string),
+		Provider:   d.Get("provider_id").(string),
+	}
+	if len(profile) > 0 {
+		factor.Profile = profile
+	}
+	return factor
+}
+
+// totpPassCode computes the RFC 6238 passcode of a base32 encoded shared
+// secret, which activates the TOTP factors enrolled on behalf of a user.
+func totpPassCode(secret string, timeStep, digits int, t time.Time) (string, error) {
+	if timeStep == 0 {
+		timeStep = 30
+	}
+	if digits == 0 {
+		digits = 6
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", err
+	}
+	if len(key) == 0 {
+		return "", errors.New("shared secret is empty")
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(t.Unix()/int64(timeStep)))
+	h := hmac.New(sha1.New, key)
+	h.Write(msg)
+	sum := h.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", digits, code%uint32(math.Pow10(digits))), nil
+}
@@ -0,0 +1,187 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccOktaUserFactor_question(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactor)
+	config := mgr.GetFixtures("question.tf", ri, t)
+	updated := mgr.GetFixtures("question_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userFactor)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createUserFactorCheckDestroy(userFactor),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "factor_type", "question"),
+					resource.TestCheckResourceAttr(resourceName, "provider_id", "OKTA"),
+					resource.TestCheckResourceAttr(resourceName, "question", "disliked_food"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "question", "name_of_first_plush_toy"),
+					resource.TestCheckResourceAttr(resourceName, "answer", "teddy bear"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"answer"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["user_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccOktaUserFactor_totp(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactor)
+	config := mgr.GetFixtures("totp.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userFactor)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createUserFactorCheckDestroy(userFactor),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "factor_type", "token:software:totp"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaUserFactor_email(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactor)
+	config := mgr.GetFixtures("email.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userFactor)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createUserFactorCheckDestroy(userFactor),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "factor_type", "email"),
+					resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("testAcc-%d@example.com", ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+// TestTotpPassCode uses the SHA1 test vectors of RFC 6238, truncated to 6 digits
+func TestTotpPassCode(t *testing.T) {
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ" // "12345678901234567890"
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		got, err := totpPassCode(secret, 30, 6, time.Unix(tt.unix, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got, "passcode at %d", tt.unix)
+	}
+	got, err := totpPassCode(secret, 0, 8, time.Unix(59, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "94287082", got)
+	_, err = totpPassCode("not base32!", 30, 6, time.Unix(59, 0))
+	assert.Error(t, err)
+}
+
+func TestValidateUserFactor(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name: "email",
+			raw:  map[string]interface{}{"user_id": "00u1", "factor_type": "email", "email": "jane@example.com"},
+		},
+		{
+			name: "call with extension",
+			raw:  map[string]interface{}{"user_id": "00u1", "factor_type": "call", "phone_number": "+15555550100", "phone_extension": "1234"},
+		},
+		{
+			name: "totp with google provider",
+			raw:  map[string]interface{}{"user_id": "00u1", "factor_type": "token:software:totp", "provider_id": "GOOGLE"},
+		},
+		{
+			name:    "sms without phone number",
+			raw:     map[string]interface{}{"user_id": "00u1", "factor_type": "sms"},
+			wantErr: true,
+		},
+		{
+			name:    "sms with extension",
+			raw:     map[string]interface{}{"user_id": "00u1", "factor_type": "sms", "phone_number": "+15555550100", "phone_extension": "1234"},
+			wantErr: true,
+		},
+		{
+			name:    "question without answer",
+			raw:     map[string]interface{}{"user_id": "00u1", "factor_type": "question", "question": "disliked_food"},
+			wantErr: true,
+		},
+		{
+			name:    "totp with email",
+			raw:     map[string]interface{}{"user_id": "00u1", "factor_type": "token:software:totp", "email": "jane@example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "email with google provider",
+			raw:     map[string]interface{}{"user_id": "00u1", "factor_type": "email", "email": "jane@example.com", "provider_id": "GOOGLE"},
+			wantErr: true,
+		},
+	}
+	r := resourceUserFactor()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(tt.raw)
+			_, err := r.Diff(context.Background(), nil, config, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
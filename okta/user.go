@@ -514,3 +514,28 @@ func waitForStatusTransition(ctx context.Context, u string, c *okta.Client) erro
 		}
 	}
 }
+
+// defaultUserMaxWait is how long a user resource waits by default for the
+// user to appear in searches after it is created or its login is changed.
+const defaultUserMaxWait = 30 * time.Second
+
+// waitForUserSearch polls the user search until it returns the user with its
+// current login, since searches are eventually consistent. A timeout is only
+// logged, the user itself has been written successfully.
+func waitForUserSearch(ctx context.Context, d *schema.ResourceData, m interface{}) {
+	client := getOktaClientFromMetadata(m)
+	qp := &query.Params{
+		Search: fmt.Sprintf(`id eq "%s" and profile.login eq "%s"`, d.Id(), d.Get("login").(string)),
+		Limit:  1,
+	}
+	err := waitForConsistency(ctx, getMaxWait(d, defaultUserMaxWait), func() (bool, error) {
+		users, _, err := client.User.ListUsers(ctx, qp)
+		if err != nil {
+			return false, fmt.Errorf("failed to search user: %v", err)
+		}
+		return len(users) > 0, nil
+	})
+	if err != nil {
+		logger(m).Warn("user is not returned by user searches yet", "id", d.Id(), "error", err)
+	}
+}
@@ -211,6 +211,17 @@ func allowLongRunningACCTest(t *testing.T) bool {
 	return allow
 }
 
+// orgFeatureACCTest Test skip helper for tests that enable an org feature,
+// returns the name of the feature the tests are allowed to enable.
+func orgFeatureACCTest(t *testing.T) string {
+	envVar := "OKTA_ACC_TEST_ORG_FEATURE"
+	name := os.Getenv(envVar)
+	if name == "" {
+		t.Skipf("%q not present, skipping test", envVar)
+	}
+	return name
+}
+
 // orgAdminOnlyTest Test skip helper for tests that should only run with a token
 // of org admin permissions, not super admin.
 func orgAdminOnlyTest(t *testing.T) bool {
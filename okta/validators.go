@@ -1,10 +1,13 @@
 package okta
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"net/url"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -141,6 +144,54 @@ func stringIsJSON(i interface{}, k cty.Path) diag.Diagnostics {
 	return nil
 }
 
+func stringIsAPIPath(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if !strings.HasPrefix(v, "/") {
+		return diag.Errorf("'%s' is not a valid API path, it should start with '/'", v)
+	}
+	return nil
+}
+
+func stringIsRFC3339(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		return diag.Errorf("'%s' is not a valid RFC3339 timestamp, e.g. '2022-11-08T12:00:00Z'", v)
+	}
+	return nil
+}
+
+func stringIsDuration(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+		return diag.Errorf("'%s' is not a valid positive duration, e.g. '720h'", v)
+	}
+	return nil
+}
+
+func stringIsPEMCertificate(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	block, _ := pem.Decode([]byte(v))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return diag.Errorf("%q is not a PEM encoded certificate", k)
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return diag.Errorf("%q contains an invalid certificate: %s", k, err)
+	}
+	return nil
+}
+
 func stringLenBetween(min, max int) schema.SchemaValidateDiagFunc {
 	return func(i interface{}, k cty.Path) diag.Diagnostics {
 		v, ok := i.(string)
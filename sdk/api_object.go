@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// APIObject is an arbitrary JSON object returned by an Okta API endpoint the
+// provider does not have a dedicated resource for.
+type APIObject map[string]interface{}
+
+func (m *APISupplement) CreateAPIObject(ctx context.Context, method, path string, body APIObject) (APIObject, *okta.Response, error) {
+	return m.doAPIObjectRequest(ctx, method, path, body)
+}
+
+func (m *APISupplement) GetAPIObject(ctx context.Context, path string) (APIObject, *okta.Response, error) {
+	return m.doAPIObjectRequest(ctx, http.MethodGet, path, nil)
+}
+
+func (m *APISupplement) UpdateAPIObject(ctx context.Context, method, path string, body APIObject) (APIObject, *okta.Response, error) {
+	return m.doAPIObjectRequest(ctx, method, path, body)
+}
+
+func (m *APISupplement) DeleteAPIObject(ctx context.Context, path string) (*okta.Response, error) {
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) doAPIObjectRequest(ctx context.Context, method, path string, body APIObject) (APIObject, *okta.Response, error) {
+	re := m.cloneRequestExecutor().WithAccept("application/json").WithContentType("application/json")
+	var (
+		req *http.Request
+		err error
+	)
+	// a nil map would otherwise be encoded as a 'null' body
+	if body == nil {
+		req, err = re.NewRequest(method, path, nil)
+	} else {
+		req, err = re.NewRequest(method, path, body)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var obj APIObject
+	resp, err := re.Do(ctx, req, &obj)
+	if err != nil {
+		return nil, resp, err
+	}
+	return obj, resp, nil
+}
+
+// GetAPIResponse returns the decoded JSON response of the path. When
+// followPagination is set and the response is a list, the following pages are
+// appended to it.
+func (m *APISupplement) GetAPIResponse(ctx context.Context, path string, followPagination bool) (interface{}, *okta.Response, error) {
+	re := m.cloneRequestExecutor().WithAccept("application/json")
+	req, err := re.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var result interface{}
+	resp, err := re.Do(ctx, req, &result)
+	if err != nil {
+		return nil, resp, err
+	}
+	list, isList := result.([]interface{})
+	if !followPagination || !isList {
+		return result, resp, nil
+	}
+	for resp.HasNextPage() {
+		var page []interface{}
+		resp, err = resp.Next(ctx, &page)
+		if err != nil {
+			return nil, resp, err
+		}
+		list = append(list, page...)
+	}
+	return list, resp, nil
+}
@@ -0,0 +1,108 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const (
+	AppFeatureInboundProvisioning = "INBOUND_PROVISIONING"
+	AppFeatureUserProvisioning    = "USER_PROVISIONING"
+)
+
+// ApplicationFeature wraps okta.ApplicationFeature, whose capabilities lack the
+// settings of the INBOUND_PROVISIONING feature.
+type ApplicationFeature struct {
+	okta.ApplicationFeature
+	Capabilities *ApplicationFeatureCapabilities `json:"capabilities,omitempty"`
+}
+
+// ApplicationFeatureCapabilities holds the capabilities of both features,
+// USER_PROVISIONING uses create and update, INBOUND_PROVISIONING uses the import ones.
+type ApplicationFeatureCapabilities struct {
+	okta.CapabilitiesObject
+	ImportSettings *ImportSettings `json:"importSettings,omitempty"`
+	ImportRules    *ImportRules    `json:"importRules,omitempty"`
+}
+
+type ImportSettings struct {
+	Username *ImportUsername `json:"username,omitempty"`
+	Schedule *ImportSchedule `json:"schedule,omitempty"`
+}
+
+type ImportUsername struct {
+	UserNameExpression string `json:"userNameExpression,omitempty"`
+	UsernameFormat     string `json:"usernameFormat,omitempty"`
+}
+
+type ImportSchedule struct {
+	Status            string                `json:"status,omitempty"`
+	FullImport        *ImportScheduleObject `json:"fullImport,omitempty"`
+	IncrementalImport *ImportScheduleObject `json:"incrementalImport,omitempty"`
+}
+
+type ImportScheduleObject struct {
+	Expression string `json:"expression,omitempty"`
+	Timezone   string `json:"timezone,omitempty"`
+}
+
+type ImportRules struct {
+	UserCreateAndMatch *ImportUserCreateAndMatch `json:"userCreateAndMatch,omitempty"`
+}
+
+type ImportUserCreateAndMatch struct {
+	ExactMatchCriteria      string `json:"exactMatchCriteria,omitempty"`
+	AllowPartialMatch       *bool  `json:"allowPartialMatch,omitempty"`
+	AutoActivateNewUsers    *bool  `json:"autoActivateNewUsers,omitempty"`
+	AutoConfirmExactMatch   *bool  `json:"autoConfirmExactMatch,omitempty"`
+	AutoConfirmNewUsers     *bool  `json:"autoConfirmNewUsers,omitempty"`
+	AutoConfirmPartialMatch *bool  `json:"autoConfirmPartialMatch,omitempty"`
+}
+
+func (m *APISupplement) ListAppFeatures(ctx context.Context, appID string) ([]*ApplicationFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features", appID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var features []*ApplicationFeature
+	resp, err := re.Do(ctx, req, &features)
+	if err != nil {
+		return nil, resp, err
+	}
+	return features, resp, nil
+}
+
+func (m *APISupplement) GetAppFeature(ctx context.Context, appID, name string) (*ApplicationFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature *ApplicationFeature
+	resp, err := re.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return feature, resp, nil
+}
+
+func (m *APISupplement) UpdateAppFeature(ctx context.Context, appID, name string, body ApplicationFeatureCapabilities) (*ApplicationFeature, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/features/%s", appID, name)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var feature *ApplicationFeature
+	resp, err := re.Do(ctx, req, &feature)
+	if err != nil {
+		return nil, resp, err
+	}
+	return feature, resp, nil
+}
@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	ProvisioningConnectionAuthSchemeOAuth2 = "OAUTH2"
+	ProvisioningConnectionAuthSchemeToken  = "TOKEN"
+)
+
+type ProvisioningConnection struct {
+	AuthScheme string                         `json:"authScheme,omitempty"`
+	BaseUrl    string                         `json:"baseUrl,omitempty"`
+	Profile    *ProvisioningConnectionProfile `json:"profile,omitempty"`
+	Status     string                         `json:"status,omitempty"`
+}
+
+type ProvisioningConnectionRequest struct {
+	BaseUrl string                         `json:"baseUrl,omitempty"`
+	Profile *ProvisioningConnectionProfile `json:"profile"`
+}
+
+// ProvisioningConnectionProfile holds the credentials of the connection, a
+// token for the TOKEN auth scheme or a client ID for the OAUTH2 one. The token
+// is never returned by the API.
+type ProvisioningConnectionProfile struct {
+	AuthScheme string `json:"authScheme"`
+	ClientId   string `json:"clientId,omitempty"`
+	Token      string `json:"token,omitempty"`
+}
+
+func (m *APISupplement) GetAppProvisioningConnection(ctx context.Context, appID string) (*ProvisioningConnection, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default", appID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var connection *ProvisioningConnection
+	resp, err := re.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+	return connection, resp, nil
+}
+
+// SetAppProvisioningConnection creates or replaces the default provisioning
+// connection of the application.
+func (m *APISupplement) SetAppProvisioningConnection(ctx context.Context, appID string, body ProvisioningConnectionRequest, qp *query.Params) (*ProvisioningConnection, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default", appID)
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var connection *ProvisioningConnection
+	resp, err := re.Do(ctx, req, &connection)
+	if err != nil {
+		return nil, resp, err
+	}
+	return connection, resp, nil
+}
+
+func (m *APISupplement) ActivateAppProvisioningConnection(ctx context.Context, appID string) (*okta.Response, error) {
+	return m.changeAppProvisioningConnectionLifecycle(ctx, appID, "activate")
+}
+
+func (m *APISupplement) DeactivateAppProvisioningConnection(ctx context.Context, appID string) (*okta.Response, error) {
+	return m.changeAppProvisioningConnectionLifecycle(ctx, appID, "deactivate")
+}
+
+func (m *APISupplement) changeAppProvisioningConnectionLifecycle(ctx context.Context, appID, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/connections/default/lifecycle/%s", appID, action)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
@@ -8,14 +8,40 @@ import (
 	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
+// AccessPolicyRule wrapper over okta.AccessPolicyRule adding the device
+// assurance condition missing from okta-sdk-golang.
+type AccessPolicyRule struct {
+	okta.AccessPolicyRule
+
+	Conditions *AccessPolicyRuleConditions `json:"conditions,omitempty"`
+}
+
+type AccessPolicyRuleConditions struct {
+	okta.AccessPolicyRuleConditions
+
+	Device *DeviceAccessPolicyRuleCondition `json:"device,omitempty"`
+}
+
+type DeviceAccessPolicyRuleCondition struct {
+	okta.DeviceAccessPolicyRuleCondition
+
+	Assurance *DeviceAssuranceCondition `json:"assurance,omitempty"`
+}
+
+// DeviceAssuranceCondition lists the IDs of the device assurance policies the
+// device has to comply with.
+type DeviceAssuranceCondition struct {
+	Include []string `json:"include,omitempty"`
+}
+
 // CreateAppSignOnPolicyRule creates a policy rule.
-func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID string, body okta.AccessPolicyRule) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules", policyID)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
@@ -24,13 +50,13 @@ func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID
 }
 
 // GetAppSignOnPolicyRule gets a policy rule.
-func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleId)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
@@ -39,13 +65,13 @@ func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ru
 }
 
 // UpdateAppSignOnPolicyRule updates a policy rule.
-func (m *APISupplement) UpdateAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string, body okta.AccessPolicyRule) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) UpdateAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleId)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
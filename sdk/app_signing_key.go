@@ -0,0 +1,45 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// GetAppSigningKeyID returns the ID of the key the app signs with.
+func (m *APISupplement) GetAppSigningKeyID(ctx context.Context, appID string) (string, *okta.Response, error) {
+	app, resp, err := m.GetAPIObject(ctx, fmt.Sprintf("/api/v1/apps/%s", appID))
+	if err != nil {
+		return "", resp, err
+	}
+	credentials, _ := app["credentials"].(map[string]interface{})
+	signing, _ := credentials["signing"].(map[string]interface{})
+	kid, _ := signing["kid"].(string)
+	return kid, resp, nil
+}
+
+// SetAppSigningKeyID switches the key the app signs with. The app is updated
+// as a raw JSON object so that the settings of every sign on mode are kept
+// as they are.
+func (m *APISupplement) SetAppSigningKeyID(ctx context.Context, appID, kid string) (*okta.Response, error) {
+	path := fmt.Sprintf("/api/v1/apps/%s", appID)
+	app, resp, err := m.GetAPIObject(ctx, path)
+	if err != nil {
+		return resp, err
+	}
+	credentials, ok := app["credentials"].(map[string]interface{})
+	if !ok {
+		credentials = map[string]interface{}{}
+		app["credentials"] = credentials
+	}
+	signing, ok := credentials["signing"].(map[string]interface{})
+	if !ok {
+		signing = map[string]interface{}{}
+		credentials["signing"] = signing
+	}
+	signing["kid"] = kid
+	_, resp, err = m.UpdateAPIObject(ctx, http.MethodPut, path, app)
+	return resp, err
+}
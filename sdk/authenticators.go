@@ -1,5 +1,14 @@
 package sdk
 
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
 // List of factors that are applicable to Okta Identity Engine (OIE)
 var AuthenticatorProviders = []string{
 	// NOTE: some authenticator types are available by feature flag on the org only
@@ -16,3 +25,67 @@ var AuthenticatorProviders = []string{
 	WebauthnFactor,
 	// YubikeyTokenFactor, // NOTE: support upcoming when available in public API
 }
+
+// Authenticator wraps okta.Authenticator, whose provider configuration lacks
+// the push providers of custom app authenticators.
+type Authenticator struct {
+	okta.Authenticator
+	AgreeToTerms *bool                  `json:"agreeToTerms,omitempty"`
+	Provider     *AuthenticatorProvider `json:"provider,omitempty"`
+}
+
+type AuthenticatorProvider struct {
+	Configuration *AuthenticatorProviderConfiguration `json:"configuration,omitempty"`
+	Type          string                              `json:"type,omitempty"`
+}
+
+type AuthenticatorProviderConfiguration struct {
+	okta.AuthenticatorProviderConfiguration
+	Apns *AuthenticatorProviderConfigurationApns `json:"apns,omitempty"`
+	Fcm  *AuthenticatorProviderConfigurationFcm  `json:"fcm,omitempty"`
+}
+
+// AuthenticatorProviderConfigurationApns references an APNS push provider
+type AuthenticatorProviderConfigurationApns struct {
+	Id               string `json:"id,omitempty"`
+	AppBundleId      string `json:"appBundleId,omitempty"`
+	DebugAppBundleId string `json:"debugAppBundleId,omitempty"`
+}
+
+// AuthenticatorProviderConfigurationFcm references an FCM push provider
+type AuthenticatorProviderConfigurationFcm struct {
+	Id string `json:"id,omitempty"`
+}
+
+func (m *APISupplement) CreateAuthenticator(ctx context.Context, body Authenticator, qp *query.Params) (*okta.Authenticator, *okta.Response, error) {
+	url := "/api/v1/authenticators"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var authenticator *okta.Authenticator
+	resp, err := re.Do(ctx, req, &authenticator)
+	if err != nil {
+		return nil, resp, err
+	}
+	return authenticator, resp, nil
+}
+
+func (m *APISupplement) UpdateAuthenticator(ctx context.Context, id string, body Authenticator) (*okta.Authenticator, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/authenticators/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var authenticator *okta.Authenticator
+	resp, err := re.Do(ctx, req, &authenticator)
+	if err != nil {
+		return nil, resp, err
+	}
+	return authenticator, resp, nil
+}
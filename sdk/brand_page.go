@@ -0,0 +1,183 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Variants of the brand's sign-in and error pages. The customized variant is
+// the live page, the preview variant is only rendered on the preview URL and
+// the default variant is the read-only page Okta falls back to.
+const (
+	BrandPageCustomized = "customized"
+	BrandPageDefault    = "default"
+	BrandPagePreview    = "preview"
+)
+
+const (
+	SignOutPageExternallyHosted = "EXTERNALLY_HOSTED"
+	SignOutPageOktaDefault      = "OKTA_DEFAULT"
+)
+
+type SignInPage struct {
+	PageContent                  string                          `json:"pageContent,omitempty"`
+	ContentSecurityPolicySetting *ContentSecurityPolicySetting   `json:"contentSecurityPolicySetting,omitempty"`
+	WidgetCustomizations         *SignInPageWidgetCustomizations `json:"widgetCustomizations,omitempty"`
+	WidgetVersion                string                          `json:"widgetVersion,omitempty"`
+}
+
+type ErrorPage struct {
+	PageContent                  string                        `json:"pageContent,omitempty"`
+	ContentSecurityPolicySetting *ContentSecurityPolicySetting `json:"contentSecurityPolicySetting,omitempty"`
+}
+
+type ContentSecurityPolicySetting struct {
+	Mode      string   `json:"mode,omitempty"`
+	ReportUri string   `json:"reportUri,omitempty"`
+	SrcList   []string `json:"srcList"`
+}
+
+type SignInPageWidgetCustomizations struct {
+	AuthenticatorPageCustomLinkLabel        string `json:"authenticatorPageCustomLinkLabel,omitempty"`
+	AuthenticatorPageCustomLinkUrl          string `json:"authenticatorPageCustomLinkUrl,omitempty"`
+	ClassicRecoveryFlowEmailOrUsernameLabel string `json:"classicRecoveryFlowEmailOrUsernameLabel,omitempty"`
+	CustomLink1Label                        string `json:"customLink1Label,omitempty"`
+	CustomLink1Url                          string `json:"customLink1Url,omitempty"`
+	CustomLink2Label                        string `json:"customLink2Label,omitempty"`
+	CustomLink2Url                          string `json:"customLink2Url,omitempty"`
+	ForgotPasswordLabel                     string `json:"forgotPasswordLabel,omitempty"`
+	ForgotPasswordUrl                       string `json:"forgotPasswordUrl,omitempty"`
+	HelpLabel                               string `json:"helpLabel,omitempty"`
+	HelpUrl                                 string `json:"helpUrl,omitempty"`
+	PasswordInfoTip                         string `json:"passwordInfoTip,omitempty"`
+	PasswordLabel                           string `json:"passwordLabel,omitempty"`
+	ShowPasswordVisibilityToggle            *bool  `json:"showPasswordVisibilityToggle,omitempty"`
+	ShowUserIdentifier                      *bool  `json:"showUserIdentifier,omitempty"`
+	SignInLabel                             string `json:"signInLabel,omitempty"`
+	UnlockAccountLabel                      string `json:"unlockAccountLabel,omitempty"`
+	UnlockAccountUrl                        string `json:"unlockAccountUrl,omitempty"`
+	UsernameInfoTip                         string `json:"usernameInfoTip,omitempty"`
+	UsernameLabel                           string `json:"usernameLabel,omitempty"`
+	WidgetGeneration                        string `json:"widgetGeneration,omitempty"`
+}
+
+// HostedPage holds the sign-out page settings of a brand
+type HostedPage struct {
+	Type string `json:"type"`
+	Url  string `json:"url,omitempty"`
+}
+
+func (m *APISupplement) GetSignInPage(ctx context.Context, brandID, variant string) (*SignInPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/sign-in/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *SignInPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+func (m *APISupplement) ReplaceSignInPage(ctx context.Context, brandID, variant string, body SignInPage) (*SignInPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/sign-in/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *SignInPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+// DeleteSignInPage resets the sign-in page variant to the default page
+func (m *APISupplement) DeleteSignInPage(ctx context.Context, brandID, variant string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/sign-in/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) GetErrorPage(ctx context.Context, brandID, variant string) (*ErrorPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/error/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *ErrorPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+func (m *APISupplement) ReplaceErrorPage(ctx context.Context, brandID, variant string, body ErrorPage) (*ErrorPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/error/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *ErrorPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+// DeleteErrorPage resets the error page variant to the default page
+func (m *APISupplement) DeleteErrorPage(ctx context.Context, brandID, variant string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/error/%s", brandID, variant)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) GetSignOutPageSettings(ctx context.Context, brandID string) (*HostedPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/sign-out/customized", brandID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *HostedPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
+
+func (m *APISupplement) ReplaceSignOutPageSettings(ctx context.Context, brandID string, body HostedPage) (*HostedPage, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/brands/%s/pages/sign-out/customized", brandID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var page *HostedPage
+	resp, err := re.Do(ctx, req, &page)
+	if err != nil {
+		return nil, resp, err
+	}
+	return page, resp, nil
+}
@@ -0,0 +1,166 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// ClientRoleAssignment is an admin role assigned to an OAuth 2.0 client. Role
+// and ResourceSet are only set for CUSTOM roles.
+type ClientRoleAssignment struct {
+	Id             string `json:"id,omitempty"`
+	Type           string `json:"type,omitempty"`
+	Label          string `json:"label,omitempty"`
+	Status         string `json:"status,omitempty"`
+	AssignmentType string `json:"assignmentType,omitempty"`
+	Role           string `json:"role,omitempty"`
+	ResourceSet    string `json:"resource-set,omitempty"`
+}
+
+func (m *APISupplement) ListClientRoles(ctx context.Context, clientID string) ([]*ClientRoleAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles", clientID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var roles []*ClientRoleAssignment
+	resp, err := re.Do(ctx, req, &roles)
+	if err != nil {
+		return nil, resp, err
+	}
+	return roles, resp, nil
+}
+
+func (m *APISupplement) GetClientRole(ctx context.Context, clientID, roleID string) (*ClientRoleAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s", clientID, roleID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role *ClientRoleAssignment
+	resp, err := re.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return role, resp, nil
+}
+
+func (m *APISupplement) AssignClientRole(ctx context.Context, clientID string, body ClientRoleAssignment) (*ClientRoleAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles", clientID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var role *ClientRoleAssignment
+	resp, err := re.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+	return role, resp, nil
+}
+
+func (m *APISupplement) UnassignClientRole(ctx context.Context, clientID, roleID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s", clientID, roleID)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) ListClientRoleGroupTargets(ctx context.Context, clientID, roleID string, qp *query.Params) ([]*okta.Group, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s/targets/groups", clientID, roleID)
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var groups []*okta.Group
+	resp, err := re.Do(ctx, req, &groups)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextGroups []*okta.Group
+		resp, err = resp.Next(ctx, &nextGroups)
+		if err != nil {
+			return nil, resp, err
+		}
+		groups = append(groups, nextGroups...)
+	}
+	return groups, resp, nil
+}
+
+func (m *APISupplement) AddClientRoleGroupTarget(ctx context.Context, clientID, roleID, groupID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s/targets/groups/%s", clientID, roleID, groupID)
+	return m.changeClientRoleTarget(ctx, http.MethodPut, url)
+}
+
+func (m *APISupplement) RemoveClientRoleGroupTarget(ctx context.Context, clientID, roleID, groupID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s/targets/groups/%s", clientID, roleID, groupID)
+	return m.changeClientRoleTarget(ctx, http.MethodDelete, url)
+}
+
+func (m *APISupplement) ListClientRoleAppTargets(ctx context.Context, clientID, roleID string, qp *query.Params) ([]*okta.CatalogApplication, *okta.Response, error) {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s/targets/catalog/apps", clientID, roleID)
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var apps []*okta.CatalogApplication
+	resp, err := re.Do(ctx, req, &apps)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextApps []*okta.CatalogApplication
+		resp, err = resp.Next(ctx, &nextApps)
+		if err != nil {
+			return nil, resp, err
+		}
+		apps = append(apps, nextApps...)
+	}
+	return apps, resp, nil
+}
+
+// AddClientRoleAppTarget scopes the role to all the instances of an app when
+// appID is empty, or to a single instance of it otherwise.
+func (m *APISupplement) AddClientRoleAppTarget(ctx context.Context, clientID, roleID, appName, appID string) (*okta.Response, error) {
+	return m.changeClientRoleTarget(ctx, http.MethodPut, clientRoleAppTargetURL(clientID, roleID, appName, appID))
+}
+
+func (m *APISupplement) RemoveClientRoleAppTarget(ctx context.Context, clientID, roleID, appName, appID string) (*okta.Response, error) {
+	return m.changeClientRoleTarget(ctx, http.MethodDelete, clientRoleAppTargetURL(clientID, roleID, appName, appID))
+}
+
+func clientRoleAppTargetURL(clientID, roleID, appName, appID string) string {
+	url := fmt.Sprintf("/oauth2/v1/clients/%s/roles/%s/targets/catalog/apps/%s", clientID, roleID, appName)
+	if appID != "" {
+		url += "/" + appID
+	}
+	return url
+}
+
+func (m *APISupplement) changeClientRoleTarget(ctx context.Context, method, url string) (*okta.Response, error) {
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(method, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
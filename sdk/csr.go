@@ -0,0 +1,37 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// PublishAppCsr publishes the PEM encoded certificate signed for the CSR of
+// the app. The okta-sdk-golang publish methods JSON encode the certificate.
+func (m *APISupplement) PublishAppCsr(ctx context.Context, appID, csrID string, certificate []byte) (*okta.JsonWebKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/credentials/csrs/%s/lifecycle/publish", appID, csrID)
+	return m.publishCsr(ctx, url, certificate)
+}
+
+// PublishIdpCsr publishes the PEM encoded certificate signed for the CSR of
+// the identity provider.
+func (m *APISupplement) PublishIdpCsr(ctx context.Context, idpID, csrID string, certificate []byte) (*okta.JsonWebKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/idps/%s/credentials/csrs/%s/lifecycle/publish", idpID, csrID)
+	return m.publishCsr(ctx, url, certificate)
+}
+
+func (m *APISupplement) publishCsr(ctx context.Context, url string, certificate []byte) (*okta.JsonWebKey, *okta.Response, error) {
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/x-pem-file").NewRequest(http.MethodPost, url, certificate)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *okta.JsonWebKey
+	resp, err := re.Do(ctx, req, &key)
+	if err != nil {
+		return nil, resp, err
+	}
+	return key, resp, nil
+}
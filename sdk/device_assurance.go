@@ -0,0 +1,185 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	DeviceAssurancePlatformAndroid  = "ANDROID"
+	DeviceAssurancePlatformChromeOS = "CHROMEOS"
+	DeviceAssurancePlatformIOS      = "IOS"
+	DeviceAssurancePlatformMacOS    = "MACOS"
+	DeviceAssurancePlatformWindows  = "WINDOWS"
+)
+
+// List of platforms device assurance policies can be created for
+var DeviceAssurancePlatforms = []string{
+	DeviceAssurancePlatformAndroid,
+	DeviceAssurancePlatformChromeOS,
+	DeviceAssurancePlatformIOS,
+	DeviceAssurancePlatformMacOS,
+	DeviceAssurancePlatformWindows,
+}
+
+const (
+	DiskEncryptionTypeAllInternalVolumes = "ALL_INTERNAL_VOLUMES" // macOS and Windows
+	DiskEncryptionTypeFull               = "FULL"                 // Android only
+	DiskEncryptionTypeUser               = "USER"                 // Android only
+
+	ScreenLockTypeBiometric = "BIOMETRIC"
+	ScreenLockTypePasscode  = "PASSCODE"
+)
+
+// DeviceAssurance is a device assurance policy, only the attributes
+// applicable to the policy's platform are set.
+type DeviceAssurance struct {
+	Id                        string                                    `json:"id,omitempty"`
+	Name                      string                                    `json:"name,omitempty"`
+	Platform                  string                                    `json:"platform,omitempty"`
+	OsVersion                 *DeviceAssuranceOsVersion                 `json:"osVersion,omitempty"`
+	DiskEncryptionType        *DeviceAssuranceDiskEncryptionType        `json:"diskEncryptionType,omitempty"`
+	ScreenLockType            *DeviceAssuranceScreenLockType            `json:"screenLockType,omitempty"`
+	SecureHardwarePresent     *bool                                     `json:"secureHardwarePresent,omitempty"`
+	Jailbreak                 *bool                                     `json:"jailbreak,omitempty"`
+	ThirdPartySignalProviders *DeviceAssuranceThirdPartySignalProviders `json:"thirdPartySignalProviders,omitempty"`
+	CreatedDate               *time.Time                                `json:"createdDate,omitempty"`
+	LastUpdate                *time.Time                                `json:"lastUpdate,omitempty"`
+}
+
+type DeviceAssuranceOsVersion struct {
+	Minimum string `json:"minimum,omitempty"`
+}
+
+type DeviceAssuranceDiskEncryptionType struct {
+	Include []string `json:"include"`
+}
+
+type DeviceAssuranceScreenLockType struct {
+	Include []string `json:"include"`
+}
+
+type DeviceAssuranceThirdPartySignalProviders struct {
+	Dtc                   *DeviceAssuranceChromeDeviceTrust     `json:"dtc,omitempty"`
+	WindowsSecurityCenter *DeviceAssuranceWindowsSecurityCenter `json:"windowsSecurityCenter,omitempty"`
+}
+
+// DeviceAssuranceChromeDeviceTrust holds the Chrome Device Trust signals of
+// all the platforms, Windows supports the largest set of them.
+type DeviceAssuranceChromeDeviceTrust struct {
+	BrowserVersion                   *DeviceAssuranceOsVersion `json:"browserVersion,omitempty"`
+	BuiltInDnsClientEnabled          *bool                     `json:"builtInDnsClientEnabled,omitempty"`
+	ChromeRemoteDesktopAppBlocked    *bool                     `json:"chromeRemoteDesktopAppBlocked,omitempty"`
+	DeviceEnrollmentDomain           string                    `json:"deviceEnrollmentDomain,omitempty"`
+	DiskEncrypted                    *bool                     `json:"diskEncrypted,omitempty"`
+	KeyTrustLevel                    string                    `json:"keyTrustLevel,omitempty"`
+	OsFirewall                       *bool                     `json:"osFirewall,omitempty"`
+	OsVersion                        *DeviceAssuranceOsVersion `json:"osVersion,omitempty"`
+	PasswordProtectionWarningTrigger string                    `json:"passwordProtectionWarningTrigger,omitempty"`
+	RealtimeUrlCheckMode             *bool                     `json:"realtimeUrlCheckMode,omitempty"`
+	SafeBrowsingProtectionLevel      string                    `json:"safeBrowsingProtectionLevel,omitempty"`
+	ScreenLockSecured                *bool                     `json:"screenLockSecured,omitempty"`
+	SiteIsolationEnabled             *bool                     `json:"siteIsolationEnabled,omitempty"`
+	// Windows only
+	CrowdStrikeAgentId        string `json:"crowdStrikeAgentId,omitempty"`
+	CrowdStrikeCustomerId     string `json:"crowdStrikeCustomerId,omitempty"`
+	SecureBootEnabled         *bool  `json:"secureBootEnabled,omitempty"`
+	ThirdPartyBlockingEnabled *bool  `json:"thirdPartyBlockingEnabled,omitempty"`
+	WindowsMachineDomain      string `json:"windowsMachineDomain,omitempty"`
+	WindowsUserDomain         string `json:"windowsUserDomain,omitempty"`
+}
+
+// DeviceAssuranceWindowsSecurityCenter holds the Windows Security Center
+// signals, each of them requires the corresponding protection to be healthy.
+type DeviceAssuranceWindowsSecurityCenter struct {
+	AntiVirus          *bool `json:"antiVirus,omitempty"`
+	AutoUpdateSettings *bool `json:"autoUpdateSettings,omitempty"`
+	Firewall           *bool `json:"firewall,omitempty"`
+	InternetSettings   *bool `json:"internetSettings,omitempty"`
+}
+
+func (m *APISupplement) ListDeviceAssurances(ctx context.Context, qp *query.Params) ([]*DeviceAssurance, *okta.Response, error) {
+	url := "/api/v1/device-assurances"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assurances []*DeviceAssurance
+	resp, err := re.Do(ctx, req, &assurances)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextAssurances []*DeviceAssurance
+		resp, err = resp.Next(ctx, &nextAssurances)
+		if err != nil {
+			return nil, resp, err
+		}
+		assurances = append(assurances, nextAssurances...)
+	}
+	return assurances, resp, nil
+}
+
+func (m *APISupplement) GetDeviceAssurance(ctx context.Context, id string) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assurance *DeviceAssurance
+	resp, err := re.Do(ctx, req, &assurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assurance, resp, nil
+}
+
+func (m *APISupplement) CreateDeviceAssurance(ctx context.Context, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := "/api/v1/device-assurances"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assurance *DeviceAssurance
+	resp, err := re.Do(ctx, req, &assurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assurance, resp, nil
+}
+
+func (m *APISupplement) UpdateDeviceAssurance(ctx context.Context, id string, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assurance *DeviceAssurance
+	resp, err := re.Do(ctx, req, &assurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assurance, resp, nil
+}
+
+func (m *APISupplement) DeleteDeviceAssurance(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
@@ -0,0 +1,114 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// Validation statuses of an email domain
+const (
+	EmailDomainStatusNotStarted = "NOT_STARTED"
+	EmailDomainStatusInProgress = "IN_PROGRESS"
+	EmailDomainStatusPolling    = "POLLING"
+	EmailDomainStatusVerified   = "VERIFIED"
+	EmailDomainStatusError      = "ERROR"
+)
+
+type EmailDomain struct {
+	Id                   string                  `json:"id,omitempty"`
+	BrandId              string                  `json:"brandId,omitempty"`
+	Domain               string                  `json:"domain,omitempty"`
+	DisplayName          string                  `json:"displayName,omitempty"`
+	UserName             string                  `json:"userName,omitempty"`
+	ValidationStatus     string                  `json:"validationStatus,omitempty"`
+	DnsValidationRecords []*EmailDomainDNSRecord `json:"dnsValidationRecords,omitempty"`
+}
+
+type EmailDomainDNSRecord struct {
+	Expiration        string `json:"expiration,omitempty"`
+	Fqdn              string `json:"fqdn,omitempty"`
+	RecordType        string `json:"recordType,omitempty"`
+	VerificationValue string `json:"verificationValue,omitempty"`
+}
+
+// UpdateEmailDomainRequest holds the attributes of an email domain that can be
+// changed after it has been created.
+type UpdateEmailDomainRequest struct {
+	DisplayName string `json:"displayName"`
+	UserName    string `json:"userName"`
+}
+
+func (m *APISupplement) CreateEmailDomain(ctx context.Context, body EmailDomain) (*EmailDomain, *okta.Response, error) {
+	url := "/api/v1/email-domains"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var emailDomain *EmailDomain
+	resp, err := re.Do(ctx, req, &emailDomain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return emailDomain, resp, nil
+}
+
+func (m *APISupplement) GetEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var emailDomain *EmailDomain
+	resp, err := re.Do(ctx, req, &emailDomain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return emailDomain, resp, nil
+}
+
+func (m *APISupplement) UpdateEmailDomain(ctx context.Context, id string, body UpdateEmailDomainRequest) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var emailDomain *EmailDomain
+	resp, err := re.Do(ctx, req, &emailDomain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return emailDomain, resp, nil
+}
+
+func (m *APISupplement) DeleteEmailDomain(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+// VerifyEmailDomain checks the DNS records of the email domain, the returned
+// email domain holds the resulting validation status.
+func (m *APISupplement) VerifyEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s/verify", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var emailDomain *EmailDomain
+	resp, err := re.Do(ctx, req, &emailDomain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return emailDomain, resp, nil
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	GroupOwnerTypeUser  = "USER"
+	GroupOwnerTypeGroup = "GROUP"
+)
+
+type GroupOwner struct {
+	Id          string     `json:"id,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+	OriginId    string     `json:"originId,omitempty"`
+	OriginType  string     `json:"originType,omitempty"`
+	Resolved    *bool      `json:"resolved,omitempty"`
+	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+}
+
+// ListGroupOwners lists all the owners of the group.
+func (m *APISupplement) ListGroupOwners(ctx context.Context, groupID string, qp *query.Params) ([]*GroupOwner, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners", groupID)
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var owners []*GroupOwner
+	resp, err := re.Do(ctx, req, &owners)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextOwners []*GroupOwner
+		resp, err = resp.Next(ctx, &nextOwners)
+		if err != nil {
+			return nil, resp, err
+		}
+		owners = append(owners, nextOwners...)
+	}
+	return owners, resp, nil
+}
+
+// AssignGroupOwner assigns a user or a group as an owner of the group.
+func (m *APISupplement) AssignGroupOwner(ctx context.Context, groupID string, body GroupOwner) (*GroupOwner, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners", groupID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var owner *GroupOwner
+	resp, err := re.Do(ctx, req, &owner)
+	if err != nil {
+		return nil, resp, err
+	}
+	return owner, resp, nil
+}
+
+// DeleteGroupOwner removes an owner from the group.
+func (m *APISupplement) DeleteGroupOwner(ctx context.Context, groupID, ownerID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners/%s", groupID, ownerID)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
@@ -0,0 +1,117 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// GroupPushMapping pushes an Okta group and its members to a group of an app.
+// The target group is either an existing group of the app, TargetGroupId, or
+// a group created by the push, TargetGroupName. TargetGroupName is only used
+// on creation, the API doesn't return it.
+type GroupPushMapping struct {
+	Id              string `json:"id,omitempty"`
+	SourceGroupId   string `json:"sourceGroupId,omitempty"`
+	TargetGroupId   string `json:"targetGroupId,omitempty"`
+	TargetGroupName string `json:"targetGroupName,omitempty"`
+	Status          string `json:"status,omitempty"`
+	Created         string `json:"created,omitempty"`
+	LastUpdated     string `json:"lastUpdated,omitempty"`
+	LastPush        string `json:"lastPush,omitempty"`
+	ErrorSummary    string `json:"errorSummary,omitempty"`
+}
+
+// ListGroupPushMappings lists the mappings of the app, of all the source groups
+// if sourceGroupID is empty. query.Params has no source group filter.
+func (m *APISupplement) ListGroupPushMappings(ctx context.Context, appID, sourceGroupID string, qp *query.Params) ([]*GroupPushMapping, *okta.Response, error) {
+	qs := url.Values{}
+	if sourceGroupID != "" {
+		qs.Set("sourceGroupId", sourceGroupID)
+	}
+	if qp != nil {
+		params, _ := url.ParseQuery(strings.TrimPrefix(qp.String(), "?"))
+		for k, v := range params {
+			qs[k] = v
+		}
+	}
+	path := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings", appID)
+	if len(qs) > 0 {
+		path += "?" + qs.Encode()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mappings []*GroupPushMapping
+	resp, err := re.Do(ctx, req, &mappings)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mappings, resp, nil
+}
+
+func (m *APISupplement) GetGroupPushMapping(ctx context.Context, appID, mappingID string) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s", appID, mappingID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mapping *GroupPushMapping
+	resp, err := re.Do(ctx, req, &mapping)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mapping, resp, nil
+}
+
+func (m *APISupplement) CreateGroupPushMapping(ctx context.Context, appID string, body GroupPushMapping) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings", appID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mapping *GroupPushMapping
+	resp, err := re.Do(ctx, req, &mapping)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mapping, resp, nil
+}
+
+// UpdateGroupPushMapping only updates the status of the mapping.
+func (m *APISupplement) UpdateGroupPushMapping(ctx context.Context, appID, mappingID, status string) (*GroupPushMapping, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s", appID, mappingID)
+	re := m.cloneRequestExecutor()
+	body := GroupPushMapping{Status: status}
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPatch, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var mapping *GroupPushMapping
+	resp, err := re.Do(ctx, req, &mapping)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mapping, resp, nil
+}
+
+// DeleteGroupPushMapping deletes an inactive mapping and, if deleteTargetGroup
+// is true, the target group in the app.
+func (m *APISupplement) DeleteGroupPushMapping(ctx context.Context, appID, mappingID string, deleteTargetGroup bool) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/apps/%s/group-push/mappings/%s?deleteTargetGroup=%t", appID, mappingID, deleteTargetGroup)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
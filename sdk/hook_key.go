@@ -0,0 +1,103 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// HookKey is a key pair generated and owned by Okta, its private key signs the
+// client assertions of hooks using the private_key_jwt OAuth 2.0 client auth.
+type HookKey struct {
+	Id          string     `json:"id,omitempty"`
+	KeyId       string     `json:"keyId,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	IsUsed      *bool      `json:"isUsed,omitempty"`
+	Created     *time.Time `json:"created,omitempty"`
+	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+}
+
+// HookKeyJwk is the public key of a hook key in JWK format
+type HookKeyJwk struct {
+	Alg string `json:"alg,omitempty"`
+	E   string `json:"e,omitempty"`
+	Kid string `json:"kid,omitempty"`
+	Kty string `json:"kty,omitempty"`
+	N   string `json:"n,omitempty"`
+	Use string `json:"use,omitempty"`
+}
+
+func (m *APISupplement) GetHookKey(ctx context.Context, id string) (*HookKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/hook-keys/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *HookKey
+	resp, err := re.Do(ctx, req, &key)
+	if err != nil {
+		return nil, resp, err
+	}
+	return key, resp, nil
+}
+
+// GetHookKeyPublicKey returns the public key of a hook key, keyID is the
+// 'keyId' of the hook key, not its ID.
+func (m *APISupplement) GetHookKeyPublicKey(ctx context.Context, keyID string) (*HookKeyJwk, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/hook-keys/public/%s", keyID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var jwk *HookKeyJwk
+	resp, err := re.Do(ctx, req, &jwk)
+	if err != nil {
+		return nil, resp, err
+	}
+	return jwk, resp, nil
+}
+
+func (m *APISupplement) CreateHookKey(ctx context.Context, body HookKey) (*HookKey, *okta.Response, error) {
+	url := "/api/v1/hook-keys"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *HookKey
+	resp, err := re.Do(ctx, req, &key)
+	if err != nil {
+		return nil, resp, err
+	}
+	return key, resp, nil
+}
+
+func (m *APISupplement) UpdateHookKey(ctx context.Context, id string, body HookKey) (*HookKey, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/hook-keys/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var key *HookKey
+	resp, err := re.Do(ctx, req, &key)
+	if err != nil {
+		return nil, resp, err
+	}
+	return key, resp, nil
+}
+
+func (m *APISupplement) DeleteHookKey(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/hook-keys/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
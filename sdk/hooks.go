@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const (
+	HookChannelTypeHTTP  = "HTTP"
+	HookChannelTypeOAuth = "OAUTH"
+
+	HookOAuthClientSecretPost = "client_secret_post"
+	HookOAuthPrivateKeyJwt    = "private_key_jwt"
+)
+
+// HookChannelConfigOAuth holds the OAuth 2.0 client settings of a hook channel
+// of type OAUTH. HookKeyId is the ID of the hook key used for private_key_jwt.
+type HookChannelConfigOAuth struct {
+	AuthType     string `json:"authType,omitempty"`
+	ClientId     string `json:"clientId,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	HookKeyId    string `json:"hookKeyId,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	TokenUrl     string `json:"tokenUrl,omitempty"`
+}
+
+// InlineHook wraps okta.InlineHook, whose channel lacks the OAuth 2.0 settings
+type InlineHook struct {
+	okta.InlineHook
+	Channel *InlineHookChannel `json:"channel,omitempty"`
+}
+
+type InlineHookChannel struct {
+	okta.InlineHookChannel
+	Config *InlineHookChannelConfig `json:"config,omitempty"`
+}
+
+type InlineHookChannelConfig struct {
+	okta.InlineHookChannelConfig
+	HookChannelConfigOAuth
+}
+
+// EventHook wraps okta.EventHook, whose channel lacks the OAuth 2.0 settings
+type EventHook struct {
+	okta.EventHook
+	Channel *EventHookChannel `json:"channel,omitempty"`
+}
+
+type EventHookChannel struct {
+	okta.EventHookChannel
+	Config *EventHookChannelConfig `json:"config,omitempty"`
+}
+
+type EventHookChannelConfig struct {
+	okta.EventHookChannelConfig
+	HookChannelConfigOAuth
+}
+
+func (m *APISupplement) GetInlineHook(ctx context.Context, id string) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *InlineHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func (m *APISupplement) CreateInlineHook(ctx context.Context, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := "/api/v1/inlineHooks"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *InlineHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func (m *APISupplement) UpdateInlineHook(ctx context.Context, id string, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *InlineHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func (m *APISupplement) GetEventHook(ctx context.Context, id string) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *EventHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func (m *APISupplement) CreateEventHook(ctx context.Context, body EventHook) (*EventHook, *okta.Response, error) {
+	url := "/api/v1/eventHooks"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *EventHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
+
+func (m *APISupplement) UpdateEventHook(ctx context.Context, id string, body EventHook) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var hook *EventHook
+	resp, err := re.Do(ctx, req, &hook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return hook, resp, nil
+}
@@ -0,0 +1,52 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// GetIdpSigningKeyID returns the ID of the key Okta signs the requests to the
+// identity provider with.
+func (m *APISupplement) GetIdpSigningKeyID(ctx context.Context, idpID string) (string, *okta.Response, error) {
+	idp, resp, err := m.GetAPIObject(ctx, fmt.Sprintf("/api/v1/idps/%s", idpID))
+	if err != nil {
+		return "", resp, err
+	}
+	protocol, _ := idp["protocol"].(map[string]interface{})
+	credentials, _ := protocol["credentials"].(map[string]interface{})
+	signing, _ := credentials["signing"].(map[string]interface{})
+	kid, _ := signing["kid"].(string)
+	return kid, resp, nil
+}
+
+// SetIdpSigningKeyID switches the key Okta signs the requests to the identity
+// provider with. The identity provider is updated as a raw JSON object so that
+// the settings of every protocol are kept as they are.
+func (m *APISupplement) SetIdpSigningKeyID(ctx context.Context, idpID, kid string) (*okta.Response, error) {
+	path := fmt.Sprintf("/api/v1/idps/%s", idpID)
+	idp, resp, err := m.GetAPIObject(ctx, path)
+	if err != nil {
+		return resp, err
+	}
+	protocol, ok := idp["protocol"].(map[string]interface{})
+	if !ok {
+		protocol = map[string]interface{}{}
+		idp["protocol"] = protocol
+	}
+	credentials, ok := protocol["credentials"].(map[string]interface{})
+	if !ok {
+		credentials = map[string]interface{}{}
+		protocol["credentials"] = credentials
+	}
+	signing, ok := credentials["signing"].(map[string]interface{})
+	if !ok {
+		signing = map[string]interface{}{}
+		credentials["signing"] = signing
+	}
+	signing["kid"] = kid
+	_, resp, err = m.UpdateAPIObject(ctx, http.MethodPut, path, idp)
+	return resp, err
+}
@@ -0,0 +1,138 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	LogStreamTypeAWSEventBridge = "aws_eventbridge"
+	LogStreamTypeSplunkCloud    = "splunk_cloud_logstreaming"
+)
+
+type LogStream struct {
+	Id          string             `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Status      string             `json:"status,omitempty"`
+	Settings    *LogStreamSettings `json:"settings,omitempty"`
+	Created     *time.Time         `json:"created,omitempty"`
+	LastUpdated *time.Time         `json:"lastUpdated,omitempty"`
+}
+
+// LogStreamSettings holds the settings of all the log stream types, only the
+// ones of the type of the stream are set.
+type LogStreamSettings struct {
+	// aws_eventbridge
+	AccountId       string `json:"accountId,omitempty"`
+	EventSourceName string `json:"eventSourceName,omitempty"`
+	Region          string `json:"region,omitempty"`
+	// splunk_cloud_logstreaming
+	Edition string `json:"edition,omitempty"`
+	Host    string `json:"host,omitempty"`
+	Token   string `json:"token,omitempty"`
+}
+
+func (m *APISupplement) ListLogStreams(ctx context.Context, qp *query.Params) ([]*LogStream, *okta.Response, error) {
+	url := "/api/v1/logStreams"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var streams []*LogStream
+	resp, err := re.Do(ctx, req, &streams)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextStreams []*LogStream
+		resp, err = resp.Next(ctx, &nextStreams)
+		if err != nil {
+			return nil, resp, err
+		}
+		streams = append(streams, nextStreams...)
+	}
+	return streams, resp, nil
+}
+
+func (m *APISupplement) GetLogStream(ctx context.Context, id string) (*LogStream, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/logStreams/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stream *LogStream
+	resp, err := re.Do(ctx, req, &stream)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stream, resp, nil
+}
+
+func (m *APISupplement) CreateLogStream(ctx context.Context, body LogStream) (*LogStream, *okta.Response, error) {
+	url := "/api/v1/logStreams"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stream *LogStream
+	resp, err := re.Do(ctx, req, &stream)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stream, resp, nil
+}
+
+func (m *APISupplement) UpdateLogStream(ctx context.Context, id string, body LogStream) (*LogStream, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/logStreams/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var stream *LogStream
+	resp, err := re.Do(ctx, req, &stream)
+	if err != nil {
+		return nil, resp, err
+	}
+	return stream, resp, nil
+}
+
+func (m *APISupplement) DeleteLogStream(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/logStreams/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) ActivateLogStream(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeLogStreamLifecycle(ctx, id, "activate")
+}
+
+func (m *APISupplement) DeactivateLogStream(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeLogStreamLifecycle(ctx, id, "deactivate")
+}
+
+func (m *APISupplement) changeLogStreamLifecycle(ctx context.Context, id, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/logStreams/%s/lifecycle/%s", id, action)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
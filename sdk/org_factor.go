@@ -17,6 +17,7 @@ type OrgFactor struct {
 
 // Current available org factors for MFA
 const (
+	CustomAppFactor        = "custom_app" // OIE only, several custom app authenticators can exist in an org
 	DuoFactor              = "duo"
 	ExternalIdpFactor      = "external_idp"
 	FidoU2fFactor          = "fido_u2f"
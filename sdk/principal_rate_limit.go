@@ -0,0 +1,104 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	PrincipalTypeSSWSToken   = "SSWS_TOKEN"
+	PrincipalTypeOAuthClient = "OAUTH_CLIENT"
+)
+
+// PrincipalRateLimit is the share of the org's rate limits that is available
+// to an API token or to an OAuth 2.0 client.
+type PrincipalRateLimit struct {
+	Id                           string `json:"id,omitempty"`
+	PrincipalId                  string `json:"principalId"`
+	PrincipalType                string `json:"principalType"`
+	DefaultPercentage            *int64 `json:"defaultPercentage,omitempty"`
+	DefaultConcurrencyPercentage *int64 `json:"defaultConcurrencyPercentage,omitempty"`
+	OrgId                        string `json:"orgId,omitempty"`
+	CreatedBy                    string `json:"createdBy,omitempty"`
+	CreatedDate                  string `json:"createdDate,omitempty"`
+	LastUpdate                   string `json:"lastUpdate,omitempty"`
+	LastUpdatedBy                string `json:"lastUpdatedBy,omitempty"`
+}
+
+// ListPrincipalRateLimits lists the principal rate limits, the filter query
+// parameter is required, e.g. `principalType eq "SSWS_TOKEN"`. All pages of
+// the result are read.
+func (m *APISupplement) ListPrincipalRateLimits(ctx context.Context, qp *query.Params) ([]*PrincipalRateLimit, *okta.Response, error) {
+	url := "/api/v1/principal-rate-limits"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var limits []*PrincipalRateLimit
+	resp, err := re.Do(ctx, req, &limits)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextLimits []*PrincipalRateLimit
+		resp, err = resp.Next(ctx, &nextLimits)
+		if err != nil {
+			return nil, resp, err
+		}
+		limits = append(limits, nextLimits...)
+	}
+	return limits, resp, nil
+}
+
+func (m *APISupplement) GetPrincipalRateLimit(ctx context.Context, id string) (*PrincipalRateLimit, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/principal-rate-limits/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var limit *PrincipalRateLimit
+	resp, err := re.Do(ctx, req, &limit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return limit, resp, nil
+}
+
+func (m *APISupplement) CreatePrincipalRateLimit(ctx context.Context, body PrincipalRateLimit) (*PrincipalRateLimit, *okta.Response, error) {
+	url := "/api/v1/principal-rate-limits"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var limit *PrincipalRateLimit
+	resp, err := re.Do(ctx, req, &limit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return limit, resp, nil
+}
+
+func (m *APISupplement) UpdatePrincipalRateLimit(ctx context.Context, id string, body PrincipalRateLimit) (*PrincipalRateLimit, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/principal-rate-limits/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var limit *PrincipalRateLimit
+	resp, err := re.Do(ctx, req, &limit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return limit, resp, nil
+}
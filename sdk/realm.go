@@ -0,0 +1,111 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	RealmTypeDefault = "DEFAULT"
+	RealmTypePartner = "PARTNER"
+)
+
+type Realm struct {
+	Id          string        `json:"id,omitempty"`
+	IsDefault   bool          `json:"isDefault,omitempty"`
+	Profile     *RealmProfile `json:"profile,omitempty"`
+	Created     *time.Time    `json:"created,omitempty"`
+	LastUpdated *time.Time    `json:"lastUpdated,omitempty"`
+}
+
+type RealmProfile struct {
+	Name      string `json:"name"`
+	RealmType string `json:"realmType,omitempty"`
+}
+
+func (m *APISupplement) ListRealms(ctx context.Context, qp *query.Params) ([]*Realm, *okta.Response, error) {
+	url := "/api/v1/realms"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var realms []*Realm
+	resp, err := re.Do(ctx, req, &realms)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextRealms []*Realm
+		resp, err = resp.Next(ctx, &nextRealms)
+		if err != nil {
+			return nil, resp, err
+		}
+		realms = append(realms, nextRealms...)
+	}
+	return realms, resp, nil
+}
+
+func (m *APISupplement) GetRealm(ctx context.Context, id string) (*Realm, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realms/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var realm *Realm
+	resp, err := re.Do(ctx, req, &realm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return realm, resp, nil
+}
+
+func (m *APISupplement) CreateRealm(ctx context.Context, body Realm) (*Realm, *okta.Response, error) {
+	url := "/api/v1/realms"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var realm *Realm
+	resp, err := re.Do(ctx, req, &realm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return realm, resp, nil
+}
+
+// UpdateRealm updates the profile of the realm, the API uses POST for it.
+func (m *APISupplement) UpdateRealm(ctx context.Context, id string, body Realm) (*Realm, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realms/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var realm *Realm
+	resp, err := re.Do(ctx, req, &realm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return realm, resp, nil
+}
+
+func (m *APISupplement) DeleteRealm(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realms/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
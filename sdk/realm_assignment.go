@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+type RealmAssignment struct {
+	Id          string                     `json:"id,omitempty"`
+	Name        string                     `json:"name,omitempty"`
+	Priority    int64                      `json:"priority,omitempty"`
+	Status      string                     `json:"status,omitempty"`
+	IsDefault   bool                       `json:"isDefault,omitempty"`
+	Conditions  *RealmAssignmentConditions `json:"conditions,omitempty"`
+	Actions     *RealmAssignmentActions    `json:"actions,omitempty"`
+	Created     *time.Time                 `json:"created,omitempty"`
+	LastUpdated *time.Time                 `json:"lastUpdated,omitempty"`
+}
+
+type RealmAssignmentConditions struct {
+	ProfileSourceId string                     `json:"profileSourceId,omitempty"`
+	Expression      *RealmAssignmentExpression `json:"expression,omitempty"`
+}
+
+type RealmAssignmentExpression struct {
+	Value string `json:"value,omitempty"`
+}
+
+type RealmAssignmentActions struct {
+	AssignUserToRealm *RealmAssignmentAssignUserToRealm `json:"assignUserToRealm,omitempty"`
+}
+
+type RealmAssignmentAssignUserToRealm struct {
+	RealmId string `json:"realmId,omitempty"`
+}
+
+func (m *APISupplement) ListRealmAssignments(ctx context.Context, qp *query.Params) ([]*RealmAssignment, *okta.Response, error) {
+	url := "/api/v1/realm-assignments"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assignments []*RealmAssignment
+	resp, err := re.Do(ctx, req, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+	for resp.HasNextPage() {
+		var nextAssignments []*RealmAssignment
+		resp, err = resp.Next(ctx, &nextAssignments)
+		if err != nil {
+			return nil, resp, err
+		}
+		assignments = append(assignments, nextAssignments...)
+	}
+	return assignments, resp, nil
+}
+
+func (m *APISupplement) GetRealmAssignment(ctx context.Context, id string) (*RealmAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realm-assignments/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assignment *RealmAssignment
+	resp, err := re.Do(ctx, req, &assignment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignment, resp, nil
+}
+
+func (m *APISupplement) CreateRealmAssignment(ctx context.Context, body RealmAssignment) (*RealmAssignment, *okta.Response, error) {
+	url := "/api/v1/realm-assignments"
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assignment *RealmAssignment
+	resp, err := re.Do(ctx, req, &assignment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignment, resp, nil
+}
+
+func (m *APISupplement) UpdateRealmAssignment(ctx context.Context, id string, body RealmAssignment) (*RealmAssignment, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realm-assignments/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var assignment *RealmAssignment
+	resp, err := re.Do(ctx, req, &assignment)
+	if err != nil {
+		return nil, resp, err
+	}
+	return assignment, resp, nil
+}
+
+func (m *APISupplement) DeleteRealmAssignment(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realm-assignments/%s", id)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) ActivateRealmAssignment(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeRealmAssignmentLifecycle(ctx, id, "activate")
+}
+
+func (m *APISupplement) DeactivateRealmAssignment(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeRealmAssignmentLifecycle(ctx, id, "deactivate")
+}
+
+func (m *APISupplement) changeRealmAssignmentLifecycle(ctx context.Context, id, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/realm-assignments/%s/lifecycle/%s", id, action)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// ListLogEvents lists the System Log events matching the query params, as raw
+// JSON, following the pagination until maxEvents events are returned. Queries
+// without 'until' are polling requests that always have a next page, so the
+// pagination also stops on the first empty page.
+func (m *APISupplement) ListLogEvents(ctx context.Context, qp *query.Params, maxEvents int) ([]json.RawMessage, *okta.Response, error) {
+	url := "/api/v1/logs"
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var events []json.RawMessage
+	resp, err := re.Do(ctx, req, &events)
+	if err != nil {
+		return nil, resp, err
+	}
+	for len(events) < maxEvents && resp.HasNextPage() {
+		var nextEvents []json.RawMessage
+		resp, err = resp.Next(ctx, &nextEvents)
+		if err != nil {
+			return nil, resp, err
+		}
+		if len(nextEvents) == 0 {
+			break
+		}
+		events = append(events, nextEvents...)
+	}
+	if len(events) > maxEvents {
+		events = events[:maxEvents]
+	}
+	return events, resp, nil
+}
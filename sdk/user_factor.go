@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 // FIXME calling undocumented public API
@@ -20,3 +22,113 @@ func (m *APISupplement) UpdateUserFactor(ctx context.Context, userId, factorId s
 	}
 	return m.RequestExecutor.Do(ctx, req, factorInstance)
 }
+
+const (
+	UserFactorTypeCall     = "call"
+	UserFactorTypeEmail    = "email"
+	UserFactorTypeQuestion = "question"
+	UserFactorTypeSms      = "sms"
+	UserFactorTypeTotp     = "token:software:totp"
+)
+
+// UserFactor is a factor enrolled by a user, the profile depends on the factor
+// type. The activation is embedded only in the response to the enrollment of
+// a factor pending activation.
+type UserFactor struct {
+	Id          string                 `json:"id,omitempty"`
+	FactorType  string                 `json:"factorType,omitempty"`
+	Provider    string                 `json:"provider,omitempty"`
+	VendorName  string                 `json:"vendorName,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Profile     map[string]interface{} `json:"profile,omitempty"`
+	Created     *time.Time             `json:"created,omitempty"`
+	LastUpdated *time.Time             `json:"lastUpdated,omitempty"`
+	Embedded    *UserFactorEmbedded    `json:"_embedded,omitempty"`
+}
+
+type UserFactorEmbedded struct {
+	Activation *UserFactorActivation `json:"activation,omitempty"`
+}
+
+type UserFactorActivation struct {
+	Encoding     string     `json:"encoding,omitempty"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	KeyLength    int        `json:"keyLength,omitempty"`
+	SharedSecret string     `json:"sharedSecret,omitempty"`
+	TimeStep     int        `json:"timeStep,omitempty"`
+}
+
+func (m *APISupplement) ListUserFactors(ctx context.Context, userID string) ([]*UserFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors", userID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var factors []*UserFactor
+	resp, err := re.Do(ctx, req, &factors)
+	if err != nil {
+		return nil, resp, err
+	}
+	return factors, resp, nil
+}
+
+func (m *APISupplement) GetUserFactor(ctx context.Context, userID, factorID string) (*UserFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors/%s", userID, factorID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var factor *UserFactor
+	resp, err := re.Do(ctx, req, &factor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return factor, resp, nil
+}
+
+func (m *APISupplement) EnrollUserFactor(ctx context.Context, userID string, body UserFactor, qp *query.Params) (*UserFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors", userID)
+	if qp != nil {
+		url += qp.String()
+	}
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var factor *UserFactor
+	resp, err := re.Do(ctx, req, &factor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return factor, resp, nil
+}
+
+func (m *APISupplement) ActivateUserFactor(ctx context.Context, userID, factorID, passCode string) (*UserFactor, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors/%s/lifecycle/activate", userID, factorID)
+	re := m.cloneRequestExecutor()
+	req, err := re.WithAccept("application/json").WithContentType("application/json").
+		NewRequest(http.MethodPost, url, map[string]string{"passCode": passCode})
+	if err != nil {
+		return nil, nil, err
+	}
+	var factor *UserFactor
+	resp, err := re.Do(ctx, req, &factor)
+	if err != nil {
+		return nil, resp, err
+	}
+	return factor, resp, nil
+}
+
+// ResetUserFactor unenrolls the factor, the user has to enroll it again
+func (m *APISupplement) ResetUserFactor(ctx context.Context, userID, factorID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/factors/%s", userID, factorID)
+	re := m.cloneRequestExecutor()
+	req, err := re.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return re.Do(ctx, req, nil)
+}